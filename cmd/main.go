@@ -16,17 +16,27 @@ package main
 
 import (
 	"context"
+	"database/sql"
+	"expvar"
 	"flag"
 	"fmt"
+	"io/ioutil"
 	"log"
 	"net"
+	"net/http"
 	"os"
 	"os/signal"
+	"path/filepath"
+	"strings"
 	"syscall"
 
+	"github.com/datacommonsorg/mixer/internal/base"
 	"github.com/datacommonsorg/mixer/internal/healthcheck"
 	pb "github.com/datacommonsorg/mixer/internal/proto"
 	"github.com/datacommonsorg/mixer/internal/server"
+	"github.com/datacommonsorg/mixer/internal/store"
+	"github.com/datacommonsorg/mixer/internal/translator"
+	"github.com/datacommonsorg/mixer/internal/util"
 	"golang.org/x/oauth2/google"
 
 	"cloud.google.com/go/bigquery"
@@ -37,17 +47,33 @@ import (
 	"google.golang.org/grpc/credentials/alts"
 	"google.golang.org/grpc/health/grpc_health_v1"
 	"google.golang.org/grpc/reflection"
+
+	// SQLite driver for --sql_driver=sqlite.
+	_ "modernc.org/sqlite"
 )
 
 var (
-	mixerProject  = flag.String("mixer_project", "", "The cloud project to run the mixer instance.")
-	storeProject  = flag.String("store_project", "", "GCP project stores Bigtable and BigQuery.")
-	bqDataset     = flag.String("bq_dataset", "", "DataCommons BigQuery dataset.")
-	baseTableName = flag.String("base_table", "", "Base cache Bigtable table.")
-	port          = flag.Int("port", 12345, "Port on which to run the server.")
-	useALTS       = flag.Bool("use_alts", false, "Whether to use ALTS server authentication")
-	bigqueryOnly  = flag.Bool("bigquery_only", false, "The service only serves sparql query")
-	schemaPath    = flag.String("schema_path", "/translator/mapping", "The directory that contains the schema mapping files")
+	mixerProject    = flag.String("mixer_project", "", "The cloud project to run the mixer instance.")
+	storeProject    = flag.String("store_project", "", "GCP project stores Bigtable and BigQuery.")
+	bqDataset       = flag.String("bq_dataset", "", "DataCommons BigQuery dataset.")
+	baseTableName   = flag.String("base_table", "", "Base cache Bigtable table.")
+	port            = flag.Int("port", 12345, "Port on which to run the server.")
+	useALTS         = flag.Bool("use_alts", false, "Whether to use ALTS server authentication")
+	bigqueryOnly    = flag.Bool("bigquery_only", false, "The service only serves sparql query")
+	schemaPath      = flag.String("schema_path", "/translator/mapping", "The directory that contains the schema mapping files")
+	schemaReload    = flag.Duration("schema_reload_interval", 0, "Interval to check the schema mapping files for changes, 0 to disable. Mappings are also reloaded on SIGHUP.")
+	tableTypes      = flag.String("table_types", "", "Optional JSON file of the table types and their subtypes, to replace the built-in table types")
+	sqlDriver       = flag.String("sql_driver", "", "Optional database/sql driver to run sparql queries on instead of BigQuery. The sqlite driver is linked into the binary.")
+	sqlSource       = flag.String("sql_source", "", "Data source name of the database/sql database.")
+	sqlDialect      = flag.String("sql_dialect", "sqlite", "SQL dialect of the database/sql database, one of postgresql and sqlite.")
+	baseCacheFile   = flag.String("base_cache_file", "", "Optional CSV file of base cache rows to serve instead of Bigtable, with row key and value columns.")
+	branchCacheFile = flag.String("branch_cache_file", "", "Optional CSV file of branch cache rows, used with --base_cache_file.")
+	rowCacheMB      = flag.Int64("row_cache_mb", 128, "Size in MB of the in-memory cache of decoded Bigtable rows, 0 to disable.")
+	adminPort       = flag.Int("admin_port", 0, "Optional port on localhost to serve the MixerAdmin API on, to manage branch caches. 0 to disable.")
+	debugPort       = flag.Int("debug_port", 0, "Optional port on localhost to serve /debug/vars on, which has the row cache counters.")
+	branchSentinels = flag.String("branch_sentinel_keys", "", "Optional comma separated row keys that a new branch cache table must have before it is used.")
+	maxQueryBytes   = flag.Int64("max_query_bytes", 0, "Maximum bytes a sparql query may process in BigQuery, 0 for no limit.")
+	typeRelation    = flag.String("type_relation", "", "Optional JSON file of the place types each place type is directly contained in, to replace the built-in type relations used for containedInPlace+ in sparql queries")
 )
 
 const (
@@ -59,12 +85,69 @@ const (
 	branchCacheVersionBucket = "datcom-control"
 )
 
+// validateMapping runs the validate-mapping subcommand, which reports all the
+// problems in the schema mapping files. It returns the exit code.
+func validateMapping(args []string) int {
+	fs := flag.NewFlagSet("validate-mapping", flag.ExitOnError)
+	schemaPath := fs.String("schema_path", "deploy/mapping", "The directory that contains the schema mapping files")
+	bqSchema := fs.String("bq_schema", "", "Optional JSON file from table name to the BigQuery table schema, to check tables and columns")
+	if err := fs.Parse(args); err != nil {
+		return 2
+	}
+	var tables translator.TableSchema
+	if *bqSchema != "" {
+		b, err := ioutil.ReadFile(*bqSchema)
+		if err != nil {
+			log.Printf("Failed to read BigQuery schema: %v", err)
+			return 2
+		}
+		tables, err = translator.ParseTableSchema(b)
+		if err != nil {
+			log.Printf("Failed to parse BigQuery schema: %v", err)
+			return 2
+		}
+	}
+	entries, err := ioutil.ReadDir(*schemaPath)
+	if err != nil {
+		log.Printf("Failed to read schema path: %v", err)
+		return 2
+	}
+	files := []*translator.MappingFile{}
+	for _, e := range entries {
+		if !strings.HasSuffix(e.Name(), ".mcf") {
+			continue
+		}
+		name := filepath.Join(*schemaPath, e.Name())
+		content, err := ioutil.ReadFile(name)
+		if err != nil {
+			log.Printf("Failed to read mapping file: %v", err)
+			return 2
+		}
+		files = append(files, &translator.MappingFile{Name: name, Content: string(content)})
+	}
+	errs := translator.ValidateMapping(files, tables)
+	for _, e := range errs {
+		fmt.Println(e)
+	}
+	if len(errs) > 0 {
+		fmt.Printf("Found %d problems in %d mapping files\n", len(errs), len(files))
+		return 1
+	}
+	fmt.Printf("Validated %d mapping files\n", len(files))
+	return 0
+}
+
 func main() {
+	if len(os.Args) > 1 && os.Args[1] == "validate-mapping" {
+		os.Exit(validateMapping(os.Args[2:]))
+	}
+
 	fmt.Println("Enter mixer main() function")
 
 	flag.Parse()
 	log.SetFlags(log.LstdFlags | log.Lshortfile)
 	ctx := context.Background()
+	var err error
 
 	credentials, error := google.FindDefaultCredentials(ctx, compute.ComputeScope)
 	if error == nil && credentials.ProjectID != "" {
@@ -79,33 +162,48 @@ func main() {
 	}
 
 	// BigQuery.
-	bqClient, err := bigquery.NewClient(ctx, *mixerProject)
-	if err != nil {
-		log.Fatalf("Failed to create Bigquery client: %v", err)
+	var bqClient *bigquery.Client
+	if *sqlDriver == "" {
+		bqClient, err = bigquery.NewClient(ctx, *mixerProject)
+		if err != nil {
+			log.Fatalf("Failed to create Bigquery client: %v", err)
+		}
 	}
 
 	var baseTable *bigtable.Table
-	var branchTable *bigtable.Table
+	var branchTableName string
+	var baseReader, branchReader store.KVReader
 	var cache *server.Cache
-	if !*bigqueryOnly {
+	if !*bigqueryOnly && *baseCacheFile != "" {
+		// Cache files, for running without Bigtable.
+		baseReader, err = store.NewFileReader(*baseCacheFile)
+		if err != nil {
+			log.Fatalf("Failed to read base cache file: %v", err)
+		}
+		if *branchCacheFile != "" {
+			branchReader, err = store.NewFileReader(*branchCacheFile)
+			if err != nil {
+				log.Fatalf("Failed to read branch cache file: %v", err)
+			}
+		}
+		cache, err = server.NewCache(ctx, baseReader)
+		if err != nil {
+			log.Fatalf("Failed to create cache: %v", err)
+		}
+	} else if !*bigqueryOnly {
 		// Base cache
 		baseTable, err = server.NewBtTable(ctx, *storeProject, baseBtInstance, *baseTableName)
 		if err != nil {
 			log.Fatalf("Failed to create BigTable client: %v", err)
 		}
-		branchTableName, err := server.ReadBranchTableName(
+		branchTableName, err = server.ReadBranchTableName(
 			ctx, branchCacheVersionBucket, branchCacheVersionFile)
 		if err != nil {
 			log.Fatalf("Failed to read branch cache folder: %v", err)
 		}
-		branchTable, err = server.NewBtTable(ctx, *storeProject, branchBtInstance, branchTableName)
-
-		if err != nil {
-			log.Fatalf("Failed to create BigTable client: %v", err)
-		}
 
 		// Cache.
-		cache, err = server.NewCache(ctx, baseTable)
+		cache, err = server.NewCache(ctx, store.NewBigtableReader(baseTable))
 		if err != nil {
 			log.Fatalf("Failed to create cache: %v", err)
 		}
@@ -116,12 +214,98 @@ func main() {
 	if err != nil {
 		log.Fatalf("Failed to create metadata: %v", err)
 	}
+	metadata.MaxQueryBytes = *maxQueryBytes
+	for _, key := range strings.Split(*branchSentinels, ",") {
+		if key != "" {
+			metadata.BranchSentinelKeys = append(metadata.BranchSentinelKeys, key)
+		}
+	}
+	if *typeRelation != "" {
+		metadata.ContainedIn, err = util.GetContainedInDepths(*typeRelation)
+		if err != nil {
+			log.Fatalf("Failed to read type relation: %v", err)
+		}
+	}
+	if *tableTypes != "" {
+		metadata.SubTypeMap, err = translator.GetSubTypeMap(*tableTypes)
+		if err != nil {
+			log.Fatalf("Failed to read table types: %v", err)
+		}
+	}
 
 	// Create server object
-	s := server.NewServer(bqClient, baseTable, branchTable, metadata, cache)
+	s := server.NewServer(bqClient, baseTable, nil, metadata, cache)
+	if baseReader != nil {
+		s.SetCacheReaders(baseReader, branchReader)
+	}
+	// Default branch cache, which is updated by the Pub/Sub subscriber. More
+	// branch caches can be added with the admin API. A rejected table is not
+	// fatal, and the base cache is served until the next update, like for an
+	// update from Pub/Sub.
+	if branchTableName != "" {
+		if err := s.UpdateBranchTable(ctx, branchTableName); err != nil {
+			log.Printf("Failed to use branch cache, serving the base cache only: %v", err)
+		}
+	}
+	// Only the row cache counters are served, as the default expvar handler
+	// also has the command line with the flags.
+	debugVars := map[string]expvar.Var{}
+	if *rowCacheMB > 0 {
+		rowCache := store.NewRowCache(*rowCacheMB << 20)
+		s.SetRowCache(rowCache)
+		debugVars["row_cache"] = expvar.Func(func() interface{} {
+			return rowCache.Stats()
+		})
+	}
+	if *debugPort > 0 {
+		mux := http.NewServeMux()
+		mux.HandleFunc("/debug/vars", func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json; charset=utf-8")
+			vars := []string{}
+			for name, v := range debugVars {
+				vars = append(vars, fmt.Sprintf("%q: %s", name, v.String()))
+			}
+			fmt.Fprintf(w, "{%s}\n", strings.Join(vars, ", "))
+		})
+		go func() {
+			log.Printf("Debug server: %v", http.ListenAndServe(
+				fmt.Sprintf("localhost:%d", *debugPort), mux))
+		}()
+	}
+
+	// Run sparql queries on a database/sql database.
+	if *sqlDriver != "" {
+		dialect, err := base.GetDialect(*sqlDialect)
+		if err != nil {
+			log.Fatalf("Failed to get SQL dialect: %v", err)
+		}
+		db, err := sql.Open(*sqlDriver, *sqlSource)
+		if err != nil {
+			log.Fatalf("Failed to open database: %v", err)
+		}
+		if err := s.SetQueryExecutor(store.NewSQLExecutor(db, dialect)); err != nil {
+			log.Fatalf("Failed to set query executor: %v", err)
+		}
+	}
+
+	// Reload schema mappings on SIGHUP and when the mapping files change.
+	hup := make(chan os.Signal, 1)
+	signal.Notify(hup, syscall.SIGHUP)
+	go func() {
+		for range hup {
+			if err := s.ReloadMappings(); err != nil {
+				log.Printf("Failed to reload schema mapping: %v", err)
+				continue
+			}
+			log.Printf("Reloaded schema mapping from %s", *schemaPath)
+		}
+	}()
+	if *schemaReload > 0 {
+		go s.WatchMappings(ctx, *schemaReload)
+	}
 
 	// Subscribe to cache update
-	if !*bigqueryOnly {
+	if !*bigqueryOnly && *baseCacheFile == "" {
 		sub, err := s.SubscribeBranchCacheUpdate(
 			ctx, *storeProject, branchCacheVersionBucket, subscriberPrefix, pubsubTopic)
 		if err != nil {
@@ -158,6 +342,21 @@ func main() {
 	healthService := healthcheck.NewHealthChecker()
 	grpc_health_v1.RegisterHealthServer(srv, healthService)
 
+	// The admin API has no authentication, so it is on a separate server that
+	// is only reachable from the same host.
+	if *adminPort > 0 {
+		adminSrv := grpc.NewServer()
+		pb.RegisterMixerAdminServer(adminSrv, s)
+		reflection.Register(adminSrv)
+		adminLis, err := net.Listen("tcp", fmt.Sprintf("localhost:%d", *adminPort))
+		if err != nil {
+			log.Fatalf("Failed to listen on admin port: %v", err)
+		}
+		go func() {
+			log.Printf("Admin server: %v", adminSrv.Serve(adminLis))
+		}()
+	}
+
 	// Listen on network
 	lis, err := net.Listen("tcp", fmt.Sprintf(":%d", *port))
 	if err != nil {
@@ -18,6 +18,7 @@ import (
 	"context"
 	"flag"
 	"fmt"
+	"io"
 	"log"
 
 	pb "github.com/datacommonsorg/mixer/internal/proto"
@@ -83,4 +84,33 @@ func main() {
 		fmt.Printf("%s\n", r.GetPayload())
 	}
 
+	{
+		// Query with streamed results, which are not bound by the message size.
+		req := &pb.QueryRequest{
+			Sparql: `SELECT ?dcid
+				WHERE {
+					?place typeOf County .
+					?place dcid ?dcid
+				}`,
+		}
+		stream, err := c.QueryStream(ctx, req)
+		if err != nil {
+			log.Fatalf("could not QueryStream: %s", err)
+		}
+		for {
+			r, err := stream.Recv()
+			if err == io.EOF {
+				break
+			}
+			if err != nil {
+				log.Fatalf("could not QueryStream: %s", err)
+			}
+			if len(r.GetHeader()) > 0 {
+				fmt.Printf("%s\n", r.GetHeader())
+			}
+			for _, row := range r.GetRows() {
+				fmt.Printf("%s\n", row.GetCells()[0].GetValue())
+			}
+		}
+	}
 }
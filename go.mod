@@ -18,5 +18,5 @@ require (
 	google.golang.org/genproto v0.0.0-20210506142907-4a47615972c2
 	google.golang.org/grpc v1.37.0
 	google.golang.org/protobuf v1.26.0
-
+	modernc.org/sqlite v1.14.6
 )
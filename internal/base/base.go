@@ -19,6 +19,8 @@ import (
 	"regexp"
 	"strings"
 
+	"github.com/datacommonsorg/mixer/internal/util"
+
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
@@ -34,20 +36,64 @@ const (
 	TypeOf = "typeOf"
 	// Triple represents Triples table name
 	Triple = "Triple"
+	// Construct represents the CONSTRUCT query form
+	Construct = "CONSTRUCT"
+	// Describe represents the DESCRIBE query form
+	Describe = "DESCRIBE"
 )
 
-func toBqTable(table string, db string) string {
-	return fmt.Sprintf("`%s.%s`", db, table)
-}
-
 // QueryOptions contains options for query.
 type QueryOptions struct {
-	Limit    int
-	Db       string
-	Prov     bool
+	Limit      int
+	Offset     int
+	Db         string
+	Prov       bool
+	Distinct   bool
+	Orderby    []*Orderby
+	Filters    []*Filter
+	GroupBy    []Node
+	Aggregates []*Aggregate
+	// Query form, which is Construct, Describe or empty for SELECT query.
+	Form string
+	// Triple template of a CONSTRUCT query.
+	Template []*Query
+	// Node identifiers of a DESCRIBE query, besides the selected nodes.
+	Describe []string
+	// SQL dialect of the translated query, BigQuery if not set. It should be
+	// the same as the dialect of the schema mappings.
+	Dialect Dialect
+	// Numbers of containedInPlace steps between place types, to expand
+	// "containedInPlace+" property paths.
+	ContainedIn map[util.TypePair][]int
+}
+
+// Orderby represents a sort key of the query result.
+type Orderby struct {
+	// The node to sort by.
+	Node Node
+	// Whether to sort in ascending order.
+	ASC bool
+}
+
+// Aggregate represents an aggregation over the values of a node.
+type Aggregate struct {
+	// Aggregate function, one of "COUNT", "SUM", "AVG", "MIN" and "MAX".
+	Func string
+	// The node to aggregate.
+	Node Node
+	// Whether to only aggregate distinct values.
 	Distinct bool
-	Orderby  string
-	ASC      bool
+	// The node that holds the aggregated value.
+	Alias Node
+}
+
+// Filter represents a boolean constraint on query nodes.
+type Filter struct {
+	// Operator in SQL form, like "=", "!=", "<", "AND", "OR" and "NOT".
+	Op string
+	// Operands of the operator. An operand is a Node, a string, a float64, a bool
+	// or a *Filter.
+	Args []interface{}
 }
 
 // Node represents a reference of a graph node in datalog query.
@@ -79,7 +125,7 @@ func (t Table) String() string {
 
 // Alias gets table's alias used in SQL query.
 func (t Table) Alias() string {
-	r, _ := regexp.Compile("[.`:-]")
+	r, _ := regexp.Compile("[.`:\"-]")
 	return r.ReplaceAllString(t.Name, "_") + t.ID
 }
 
@@ -91,8 +137,9 @@ type Entity struct {
 	Table Table
 }
 
-// NewEntity creates a new Entity instance.
-func NewEntity(s string, db string) (*Entity, error) {
+// NewEntity creates a new Entity instance. The table name is in BigQuery
+// dialect unless another dialect is given.
+func NewEntity(s string, db string, dialect ...Dialect) (*Entity, error) {
 	if !strings.HasPrefix(s, PreE) {
 		return nil, status.Error(codes.InvalidArgument, "Invalid input for Entity")
 	}
@@ -100,7 +147,7 @@ func NewEntity(s string, db string) (*Entity, error) {
 	if len(parts) != 2 {
 		return nil, status.Error(codes.InvalidArgument, "Invalid input for Entity")
 	}
-	return &Entity{ID: parts[1], Table: Table{Name: toBqTable(parts[0], db)}}, nil
+	return &Entity{ID: parts[1], Table: Table{Name: getDialect(dialect).Table(db, parts[0])}}, nil
 }
 
 func (e Entity) String() string {
@@ -130,7 +177,7 @@ func (c Column) Key() string {
 }
 
 // NewColumn creates a new Column instance.
-func NewColumn(s string, db string) (*Column, error) {
+func NewColumn(s string, db string, dialect ...Dialect) (*Column, error) {
 	if !strings.HasPrefix(s, PreC) {
 		return nil, status.Error(codes.InvalidArgument, "Invalid input for Column")
 	}
@@ -138,7 +185,7 @@ func NewColumn(s string, db string) (*Column, error) {
 	if len(parts) != 2 {
 		return nil, status.Error(codes.InvalidArgument, "Invalid input for Column")
 	}
-	return &Column{Name: parts[1], Table: Table{Name: toBqTable(parts[0], db)}}, nil
+	return &Column{Name: parts[1], Table: Table{Name: getDialect(dialect).Table(db, parts[0])}}, nil
 }
 
 // FuncDeps represents the functional deps predicate.
@@ -156,12 +203,12 @@ type Mapping struct {
 }
 
 // NewMapping creates a new Mapping instance.
-func NewMapping(pred, sub, obj, db string) (*Mapping, error) {
+func NewMapping(pred, sub, obj, db string, dialect ...Dialect) (*Mapping, error) {
 	var p interface{}
 	var o interface{}
 	var err error
 
-	s, err := NewEntity(sub, db)
+	s, err := NewEntity(sub, db, dialect...)
 	if err != nil {
 		return nil, err
 	}
@@ -169,7 +216,7 @@ func NewMapping(pred, sub, obj, db string) (*Mapping, error) {
 	if pred == "functionalDeps" {
 		p = FuncDeps{}
 	} else if strings.HasPrefix(pred, PreC) {
-		p, err = NewColumn(pred, db)
+		p, err = NewColumn(pred, db, dialect...)
 		if err != nil {
 			return nil, err
 		}
@@ -186,13 +233,13 @@ func NewMapping(pred, sub, obj, db string) (*Mapping, error) {
 		o = objList
 	} else {
 		if strings.HasPrefix(obj, PreC) {
-			o, err = NewColumn(obj, db)
+			o, err = NewColumn(obj, db, dialect...)
 			if err != nil {
 				return nil, err
 			}
 			o = *o.(*Column)
 		} else if strings.HasPrefix(obj, PreE) {
-			o, err = NewEntity(obj, db)
+			o, err = NewEntity(obj, db, dialect...)
 			o = *o.(*Entity)
 		} else {
 			o = obj
@@ -218,6 +265,14 @@ type Query struct {
 	Sub Node
 	// Query object is a node or string.
 	Obj interface{}
+	// Whether the statement is from an OPTIONAL graph pattern.
+	Optional bool
+	// Index of the UNION branch of the statement. Statements outside of UNION
+	// patterns are copied to every branch.
+	Branch int
+	// Whether the predicate is repeated one or more times, from a "+" step of a
+	// property path.
+	Transitive bool
 }
 
 // NewQuery creates a new Query instance.
@@ -186,3 +186,40 @@ func TestTableAlias(t *testing.T) {
 		}
 	}
 }
+
+func TestDialect(t *testing.T) {
+	for _, c := range []struct {
+		name      string
+		db        string
+		wantTable string
+		wantStr   string
+		wantAlias string
+	}{
+		{"", "dc", "`dc.Place`", `"O'Brien \"Bay\""`, "_dc_Place_E1"},
+		{"bigquery", "dc", "`dc.Place`", `"O'Brien \"Bay\""`, "_dc_Place_E1"},
+		{"postgresql", "dc", `"dc"."Place"`, `'O''Brien "Bay"'`, "_dc___Place_E1"},
+		{"sqlite", "", `"Place"`, `'O''Brien "Bay"'`, "_Place_E1"},
+	} {
+		d, err := GetDialect(c.name)
+		if err != nil {
+			t.Fatalf("GetDialect(%s) = %s", c.name, err)
+		}
+		e, err := NewEntity("E:Place->E1", c.db, d)
+		if err != nil {
+			t.Fatalf("NewEntity() = %s", err)
+		}
+		if e.Table.Name != c.wantTable {
+			t.Errorf("%s table = %s, want %s", c.name, e.Table.Name, c.wantTable)
+		}
+		if got := d.String(`O'Brien "Bay"`); got != c.wantStr {
+			t.Errorf("%s String() = %s, want %s", c.name, got, c.wantStr)
+		}
+		e.Table.ID = "E1"
+		if got := e.Table.Alias(); got != c.wantAlias {
+			t.Errorf("%s Alias() = %s, want %s", c.name, got, c.wantAlias)
+		}
+	}
+	if _, err := GetDialect("oracle"); err == nil {
+		t.Errorf("GetDialect(oracle) = nil, want error")
+	}
+}
@@ -0,0 +1,129 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package base
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Dialect is the SQL dialect of a database that translated queries run on.
+type Dialect interface {
+	// Name gets the dialect name, like "bigquery".
+	Name() string
+	// Table gets the table identifier of a table in a database. The database
+	// can be empty for dialects that have a default database.
+	Table(db, table string) string
+	// String gets the SQL string literal of a string value.
+	String(s string) string
+	// ContainsWord gets the SQL condition that a string expression contains a
+	// whole word.
+	ContainsWord(expr, word string) string
+}
+
+type bigQueryDialect struct{}
+
+func (bigQueryDialect) Name() string { return "bigquery" }
+
+func (bigQueryDialect) Table(db, table string) string {
+	return fmt.Sprintf("`%s.%s`", db, table)
+}
+
+func (bigQueryDialect) String(s string) string {
+	return strconv.Quote(s)
+}
+
+func (d bigQueryDialect) ContainsWord(expr, word string) string {
+	return fmt.Sprintf("REGEXP_CONTAINS(%s, %s)",
+		expr, d.String(`\b`+regexp.QuoteMeta(word)+`\b`))
+}
+
+// ansiDialect quotes identifiers with double quotes and strings with single
+// quotes, as in PostgreSQL and SQLite.
+type ansiDialect struct{}
+
+func (ansiDialect) Table(db, table string) string {
+	if db == "" {
+		return quoteIdentifier(table)
+	}
+	return quoteIdentifier(db) + "." + quoteIdentifier(table)
+}
+
+func (ansiDialect) String(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", "''") + "'"
+}
+
+func quoteIdentifier(s string) string {
+	return `"` + strings.ReplaceAll(s, `"`, `""`) + `"`
+}
+
+type postgreSQLDialect struct {
+	ansiDialect
+}
+
+func (postgreSQLDialect) Name() string { return "postgresql" }
+
+func (d postgreSQLDialect) ContainsWord(expr, word string) string {
+	return fmt.Sprintf("%s ~ %s", expr, d.String(`\y`+regexp.QuoteMeta(word)+`\y`))
+}
+
+type sqliteDialect struct {
+	ansiDialect
+}
+
+func (sqliteDialect) Name() string { return "sqlite" }
+
+// ContainsWord matches words separated by spaces, since SQLite has no regular
+// expression function by default.
+func (d sqliteDialect) ContainsWord(expr, word string) string {
+	escaped := strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`).Replace(word)
+	return fmt.Sprintf(`(' ' || %s || ' ') LIKE %s ESCAPE '\'`,
+		expr, d.String("% "+escaped+" %"))
+}
+
+var (
+	// BigQuery is the GoogleSQL dialect of BigQuery.
+	BigQuery Dialect = bigQueryDialect{}
+	// PostgreSQL is the PostgreSQL dialect. The database is the schema name.
+	PostgreSQL Dialect = postgreSQLDialect{}
+	// SQLite is the SQLite dialect. The database is the attached database name.
+	SQLite Dialect = sqliteDialect{}
+)
+
+// GetDialect gets a SQL dialect by name. BigQuery is used for empty name.
+func GetDialect(name string) (Dialect, error) {
+	switch strings.ToLower(name) {
+	case "", "bigquery":
+		return BigQuery, nil
+	case "postgresql", "postgres":
+		return PostgreSQL, nil
+	case "sqlite":
+		return SQLite, nil
+	}
+	return nil, status.Errorf(codes.InvalidArgument, "Unsupported SQL dialect %s", name)
+}
+
+// getDialect gets the first dialect, or BigQuery if none is given.
+func getDialect(dialect []Dialect) Dialect {
+	if len(dialect) > 0 && dialect[0] != nil {
+		return dialect[0]
+	}
+	return BigQuery
+}
@@ -0,0 +1,282 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+
+package proto
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion6
+
+// MixerAdminClient is the client API for MixerAdmin service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type MixerAdminClient interface {
+	// Lists the branch caches.
+	ListBranchCaches(ctx context.Context, in *ListBranchCachesRequest, opts ...grpc.CallOption) (*BranchCaches, error)
+	// Adds a branch cache with the highest priority.
+	AddBranchCache(ctx context.Context, in *AddBranchCacheRequest, opts ...grpc.CallOption) (*BranchCaches, error)
+	// Replaces the table of a branch cache, keeping the replaced table for
+	// rollback.
+	UpdateBranchCache(ctx context.Context, in *UpdateBranchCacheRequest, opts ...grpc.CallOption) (*BranchCaches, error)
+	// Removes a branch cache.
+	RemoveBranchCache(ctx context.Context, in *RemoveBranchCacheRequest, opts ...grpc.CallOption) (*BranchCaches, error)
+	// Sets the priority of the branch caches.
+	ReorderBranchCaches(ctx context.Context, in *ReorderBranchCachesRequest, opts ...grpc.CallOption) (*BranchCaches, error)
+	// Swaps a branch cache back to its previous table.
+	RollbackBranchCache(ctx context.Context, in *RollbackBranchCacheRequest, opts ...grpc.CallOption) (*BranchCaches, error)
+}
+
+type mixerAdminClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewMixerAdminClient(cc grpc.ClientConnInterface) MixerAdminClient {
+	return &mixerAdminClient{cc}
+}
+
+func (c *mixerAdminClient) ListBranchCaches(ctx context.Context, in *ListBranchCachesRequest, opts ...grpc.CallOption) (*BranchCaches, error) {
+	out := new(BranchCaches)
+	err := c.cc.Invoke(ctx, "/datacommons.MixerAdmin/ListBranchCaches", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mixerAdminClient) AddBranchCache(ctx context.Context, in *AddBranchCacheRequest, opts ...grpc.CallOption) (*BranchCaches, error) {
+	out := new(BranchCaches)
+	err := c.cc.Invoke(ctx, "/datacommons.MixerAdmin/AddBranchCache", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mixerAdminClient) UpdateBranchCache(ctx context.Context, in *UpdateBranchCacheRequest, opts ...grpc.CallOption) (*BranchCaches, error) {
+	out := new(BranchCaches)
+	err := c.cc.Invoke(ctx, "/datacommons.MixerAdmin/UpdateBranchCache", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mixerAdminClient) RemoveBranchCache(ctx context.Context, in *RemoveBranchCacheRequest, opts ...grpc.CallOption) (*BranchCaches, error) {
+	out := new(BranchCaches)
+	err := c.cc.Invoke(ctx, "/datacommons.MixerAdmin/RemoveBranchCache", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mixerAdminClient) ReorderBranchCaches(ctx context.Context, in *ReorderBranchCachesRequest, opts ...grpc.CallOption) (*BranchCaches, error) {
+	out := new(BranchCaches)
+	err := c.cc.Invoke(ctx, "/datacommons.MixerAdmin/ReorderBranchCaches", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mixerAdminClient) RollbackBranchCache(ctx context.Context, in *RollbackBranchCacheRequest, opts ...grpc.CallOption) (*BranchCaches, error) {
+	out := new(BranchCaches)
+	err := c.cc.Invoke(ctx, "/datacommons.MixerAdmin/RollbackBranchCache", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MixerAdminServer is the server API for MixerAdmin service.
+// All implementations should embed UnimplementedMixerAdminServer
+// for forward compatibility
+type MixerAdminServer interface {
+	// Lists the branch caches.
+	ListBranchCaches(context.Context, *ListBranchCachesRequest) (*BranchCaches, error)
+	// Adds a branch cache with the highest priority.
+	AddBranchCache(context.Context, *AddBranchCacheRequest) (*BranchCaches, error)
+	// Replaces the table of a branch cache, keeping the replaced table for
+	// rollback.
+	UpdateBranchCache(context.Context, *UpdateBranchCacheRequest) (*BranchCaches, error)
+	// Removes a branch cache.
+	RemoveBranchCache(context.Context, *RemoveBranchCacheRequest) (*BranchCaches, error)
+	// Sets the priority of the branch caches.
+	ReorderBranchCaches(context.Context, *ReorderBranchCachesRequest) (*BranchCaches, error)
+	// Swaps a branch cache back to its previous table.
+	RollbackBranchCache(context.Context, *RollbackBranchCacheRequest) (*BranchCaches, error)
+}
+
+// UnimplementedMixerAdminServer should be embedded to have forward compatible implementations.
+type UnimplementedMixerAdminServer struct {
+}
+
+func (*UnimplementedMixerAdminServer) ListBranchCaches(context.Context, *ListBranchCachesRequest) (*BranchCaches, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListBranchCaches not implemented")
+}
+func (*UnimplementedMixerAdminServer) AddBranchCache(context.Context, *AddBranchCacheRequest) (*BranchCaches, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddBranchCache not implemented")
+}
+func (*UnimplementedMixerAdminServer) UpdateBranchCache(context.Context, *UpdateBranchCacheRequest) (*BranchCaches, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateBranchCache not implemented")
+}
+func (*UnimplementedMixerAdminServer) RemoveBranchCache(context.Context, *RemoveBranchCacheRequest) (*BranchCaches, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveBranchCache not implemented")
+}
+func (*UnimplementedMixerAdminServer) ReorderBranchCaches(context.Context, *ReorderBranchCachesRequest) (*BranchCaches, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReorderBranchCaches not implemented")
+}
+func (*UnimplementedMixerAdminServer) RollbackBranchCache(context.Context, *RollbackBranchCacheRequest) (*BranchCaches, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RollbackBranchCache not implemented")
+}
+
+func RegisterMixerAdminServer(s *grpc.Server, srv MixerAdminServer) {
+	s.RegisterService(&_MixerAdmin_serviceDesc, srv)
+}
+
+func _MixerAdmin_ListBranchCaches_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListBranchCachesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MixerAdminServer).ListBranchCaches(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/datacommons.MixerAdmin/ListBranchCaches",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MixerAdminServer).ListBranchCaches(ctx, req.(*ListBranchCachesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MixerAdmin_AddBranchCache_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddBranchCacheRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MixerAdminServer).AddBranchCache(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/datacommons.MixerAdmin/AddBranchCache",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MixerAdminServer).AddBranchCache(ctx, req.(*AddBranchCacheRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MixerAdmin_UpdateBranchCache_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateBranchCacheRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MixerAdminServer).UpdateBranchCache(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/datacommons.MixerAdmin/UpdateBranchCache",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MixerAdminServer).UpdateBranchCache(ctx, req.(*UpdateBranchCacheRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MixerAdmin_RemoveBranchCache_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveBranchCacheRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MixerAdminServer).RemoveBranchCache(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/datacommons.MixerAdmin/RemoveBranchCache",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MixerAdminServer).RemoveBranchCache(ctx, req.(*RemoveBranchCacheRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MixerAdmin_ReorderBranchCaches_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReorderBranchCachesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MixerAdminServer).ReorderBranchCaches(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/datacommons.MixerAdmin/ReorderBranchCaches",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MixerAdminServer).ReorderBranchCaches(ctx, req.(*ReorderBranchCachesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MixerAdmin_RollbackBranchCache_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RollbackBranchCacheRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MixerAdminServer).RollbackBranchCache(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/datacommons.MixerAdmin/RollbackBranchCache",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MixerAdminServer).RollbackBranchCache(ctx, req.(*RollbackBranchCacheRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _MixerAdmin_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "datacommons.MixerAdmin",
+	HandlerType: (*MixerAdminServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListBranchCaches",
+			Handler:    _MixerAdmin_ListBranchCaches_Handler,
+		},
+		{
+			MethodName: "AddBranchCache",
+			Handler:    _MixerAdmin_AddBranchCache_Handler,
+		},
+		{
+			MethodName: "UpdateBranchCache",
+			Handler:    _MixerAdmin_UpdateBranchCache_Handler,
+		},
+		{
+			MethodName: "RemoveBranchCache",
+			Handler:    _MixerAdmin_RemoveBranchCache_Handler,
+		},
+		{
+			MethodName: "ReorderBranchCaches",
+			Handler:    _MixerAdmin_ReorderBranchCaches_Handler,
+		},
+		{
+			MethodName: "RollbackBranchCache",
+			Handler:    _MixerAdmin_RollbackBranchCache_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "admin.proto",
+}
@@ -48,6 +48,29 @@ type QueryRequest struct {
 
 	// Sparql query string.
 	Sparql string `protobuf:"bytes,1,opt,name=sparql,proto3" json:"sparql,omitempty"`
+	// Maximum number of rows to return in one response. When set, the response
+	// contains a next_page_token to fetch the following rows.
+	PageSize int32 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// Opaque token returned as next_page_token from a previous response to the
+	// same sparql query. When set, rows are read from the existing query result
+	// without running the query again. On databases other than BigQuery, the
+	// query runs again for each page. Page order is only stable with a total
+	// order, so the ORDER BY variables must be selected, and rows that tie on
+	// them are sorted by all the selected variables.
+	PageToken string `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// Result format of a SELECT query, one of "json", "csv" and "tsv" for the
+	// SPARQL 1.1 Query Results JSON, CSV and TSV formats. When set, the results
+	// are returned in payload instead of header and rows. The provenance of a
+	// variable ?x is bound to ?x_provenance, so selected variables can not end
+	// with "_provenance".
+	Format string `protobuf:"bytes,4,opt,name=format,proto3" json:"format,omitempty"`
+	// When true, the SELECT query is validated and estimated by a BigQuery dry
+	// run without being executed. The response contains the header, the
+	// estimated bytes processed and the referenced tables, but no rows.
+	DryRun bool `protobuf:"varint,5,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	// Maximum number of bytes the query is allowed to process. Queries estimated
+	// above it are rejected. It can only lower the limit set for the server.
+	MaxBytesProcessed int64 `protobuf:"varint,6,opt,name=max_bytes_processed,json=maxBytesProcessed,proto3" json:"max_bytes_processed,omitempty"`
 }
 
 func (x *QueryRequest) Reset() {
@@ -89,6 +112,41 @@ func (x *QueryRequest) GetSparql() string {
 	return ""
 }
 
+func (x *QueryRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *QueryRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *QueryRequest) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+func (x *QueryRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *QueryRequest) GetMaxBytesProcessed() int64 {
+	if x != nil {
+		return x.MaxBytesProcessed
+	}
+	return 0
+}
+
 // Cell in the QueryResponse
 type QueryResponseCell struct {
 	state         protoimpl.MessageState
@@ -99,6 +157,16 @@ type QueryResponseCell struct {
 	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
 	// Provenance ID.
 	ProvenanceId string `protobuf:"bytes,2,opt,name=provenance_id,json=provenanceId,proto3" json:"provenance_id,omitempty"`
+	// Typed cell value based on the BigQuery column type. It is not set for NULL.
+	//
+	// Types that are assignable to TypedValue:
+	//	*QueryResponseCell_IntValue
+	//	*QueryResponseCell_DoubleValue
+	//	*QueryResponseCell_BoolValue
+	//	*QueryResponseCell_DateValue
+	//	*QueryResponseCell_StringValue
+	//	*QueryResponseCell_ListValue
+	TypedValue isQueryResponseCell_TypedValue `protobuf_oneof:"typed_value"`
 }
 
 func (x *QueryResponseCell) Reset() {
@@ -147,6 +215,149 @@ func (x *QueryResponseCell) GetProvenanceId() string {
 	return ""
 }
 
+func (m *QueryResponseCell) GetTypedValue() isQueryResponseCell_TypedValue {
+	if m != nil {
+		return m.TypedValue
+	}
+	return nil
+}
+
+func (x *QueryResponseCell) GetIntValue() int64 {
+	if x, ok := x.GetTypedValue().(*QueryResponseCell_IntValue); ok {
+		return x.IntValue
+	}
+	return 0
+}
+
+func (x *QueryResponseCell) GetDoubleValue() float64 {
+	if x, ok := x.GetTypedValue().(*QueryResponseCell_DoubleValue); ok {
+		return x.DoubleValue
+	}
+	return 0
+}
+
+func (x *QueryResponseCell) GetBoolValue() bool {
+	if x, ok := x.GetTypedValue().(*QueryResponseCell_BoolValue); ok {
+		return x.BoolValue
+	}
+	return false
+}
+
+func (x *QueryResponseCell) GetDateValue() string {
+	if x, ok := x.GetTypedValue().(*QueryResponseCell_DateValue); ok {
+		return x.DateValue
+	}
+	return ""
+}
+
+func (x *QueryResponseCell) GetStringValue() string {
+	if x, ok := x.GetTypedValue().(*QueryResponseCell_StringValue); ok {
+		return x.StringValue
+	}
+	return ""
+}
+
+func (x *QueryResponseCell) GetListValue() *QueryResponseCellList {
+	if x, ok := x.GetTypedValue().(*QueryResponseCell_ListValue); ok {
+		return x.ListValue
+	}
+	return nil
+}
+
+type isQueryResponseCell_TypedValue interface {
+	isQueryResponseCell_TypedValue()
+}
+
+type QueryResponseCell_IntValue struct {
+	// For INTEGER column.
+	IntValue int64 `protobuf:"varint,3,opt,name=int_value,json=intValue,proto3,oneof"`
+}
+
+type QueryResponseCell_DoubleValue struct {
+	// For FLOAT, NUMERIC and BIGNUMERIC column.
+	DoubleValue float64 `protobuf:"fixed64,4,opt,name=double_value,json=doubleValue,proto3,oneof"`
+}
+
+type QueryResponseCell_BoolValue struct {
+	// For BOOLEAN column.
+	BoolValue bool `protobuf:"varint,5,opt,name=bool_value,json=boolValue,proto3,oneof"`
+}
+
+type QueryResponseCell_DateValue struct {
+	// For DATE, DATETIME, TIME and TIMESTAMP column, in ISO 8601 format.
+	DateValue string `protobuf:"bytes,6,opt,name=date_value,json=dateValue,proto3,oneof"`
+}
+
+type QueryResponseCell_StringValue struct {
+	// For STRING, GEOGRAPHY and BYTES column. BYTES are base64 encoded.
+	StringValue string `protobuf:"bytes,7,opt,name=string_value,json=stringValue,proto3,oneof"`
+}
+
+type QueryResponseCell_ListValue struct {
+	// For REPEATED and RECORD column.
+	ListValue *QueryResponseCellList `protobuf:"bytes,8,opt,name=list_value,json=listValue,proto3,oneof"`
+}
+
+func (*QueryResponseCell_IntValue) isQueryResponseCell_TypedValue() {}
+
+func (*QueryResponseCell_DoubleValue) isQueryResponseCell_TypedValue() {}
+
+func (*QueryResponseCell_BoolValue) isQueryResponseCell_TypedValue() {}
+
+func (*QueryResponseCell_DateValue) isQueryResponseCell_TypedValue() {}
+
+func (*QueryResponseCell_StringValue) isQueryResponseCell_TypedValue() {}
+
+func (*QueryResponseCell_ListValue) isQueryResponseCell_TypedValue() {}
+
+// List of cell values in the QueryResponse.
+type QueryResponseCellList struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Cells []*QueryResponseCell `protobuf:"bytes,1,rep,name=cells,proto3" json:"cells,omitempty"`
+}
+
+func (x *QueryResponseCellList) Reset() {
+	*x = QueryResponseCellList{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mixer_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryResponseCellList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryResponseCellList) ProtoMessage() {}
+
+func (x *QueryResponseCellList) ProtoReflect() protoreflect.Message {
+	mi := &file_mixer_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryResponseCellList.ProtoReflect.Descriptor instead.
+func (*QueryResponseCellList) Descriptor() ([]byte, []int) {
+	return file_mixer_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *QueryResponseCellList) GetCells() []*QueryResponseCell {
+	if x != nil {
+		return x.Cells
+	}
+	return nil
+}
+
 // A graph query response row corresponding to the query variables in graph
 // query.
 type QueryResponseRow struct {
@@ -161,7 +372,7 @@ type QueryResponseRow struct {
 func (x *QueryResponseRow) Reset() {
 	*x = QueryResponseRow{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixer_proto_msgTypes[2]
+		mi := &file_mixer_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -174,7 +385,7 @@ func (x *QueryResponseRow) String() string {
 func (*QueryResponseRow) ProtoMessage() {}
 
 func (x *QueryResponseRow) ProtoReflect() protoreflect.Message {
-	mi := &file_mixer_proto_msgTypes[2]
+	mi := &file_mixer_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -187,7 +398,7 @@ func (x *QueryResponseRow) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use QueryResponseRow.ProtoReflect.Descriptor instead.
 func (*QueryResponseRow) Descriptor() ([]byte, []int) {
-	return file_mixer_proto_rawDescGZIP(), []int{2}
+	return file_mixer_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *QueryResponseRow) GetCells() []*QueryResponseCell {
@@ -209,12 +420,27 @@ type QueryResponse struct {
 	// Query results, with each row containing cells corresponding to header
 	// variable order.
 	Rows []*QueryResponseRow `protobuf:"bytes,2,rep,name=rows,proto3" json:"rows,omitempty"`
+	// Token to fetch the next page of results. Empty when there are no more
+	// rows.
+	NextPageToken string `protobuf:"bytes,3,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	// Result triples of a CONSTRUCT or DESCRIBE query, which has no header and
+	// rows.
+	Triples []*Triple `protobuf:"bytes,4,rep,name=triples,proto3" json:"triples,omitempty"`
+	// Query results in the requested format.
+	Payload []byte `protobuf:"bytes,5,opt,name=payload,proto3" json:"payload,omitempty"`
+	// Media type of the payload, like "application/sparql-results+json".
+	ContentType string `protobuf:"bytes,6,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	// Estimated number of bytes processed by the query, set for dry run.
+	TotalBytesProcessed int64 `protobuf:"varint,7,opt,name=total_bytes_processed,json=totalBytesProcessed,proto3" json:"total_bytes_processed,omitempty"`
+	// Tables referenced by the query in "project.dataset.table" format, set for
+	// dry run.
+	ReferencedTables []string `protobuf:"bytes,8,rep,name=referenced_tables,json=referencedTables,proto3" json:"referenced_tables,omitempty"`
 }
 
 func (x *QueryResponse) Reset() {
 	*x = QueryResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixer_proto_msgTypes[3]
+		mi := &file_mixer_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -227,7 +453,7 @@ func (x *QueryResponse) String() string {
 func (*QueryResponse) ProtoMessage() {}
 
 func (x *QueryResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_mixer_proto_msgTypes[3]
+	mi := &file_mixer_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -240,7 +466,7 @@ func (x *QueryResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use QueryResponse.ProtoReflect.Descriptor instead.
 func (*QueryResponse) Descriptor() ([]byte, []int) {
-	return file_mixer_proto_rawDescGZIP(), []int{3}
+	return file_mixer_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *QueryResponse) GetHeader() []string {
@@ -257,6 +483,109 @@ func (x *QueryResponse) GetRows() []*QueryResponseRow {
 	return nil
 }
 
+func (x *QueryResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *QueryResponse) GetTriples() []*Triple {
+	if x != nil {
+		return x.Triples
+	}
+	return nil
+}
+
+func (x *QueryResponse) GetPayload() []byte {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+func (x *QueryResponse) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *QueryResponse) GetTotalBytesProcessed() int64 {
+	if x != nil {
+		return x.TotalBytesProcessed
+	}
+	return 0
+}
+
+func (x *QueryResponse) GetReferencedTables() []string {
+	if x != nil {
+		return x.ReferencedTables
+	}
+	return nil
+}
+
+// Graph query response streamed in parts. The first response contains the
+// header only, and each following response contains a batch of rows.
+type QueryStreamResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Identifier for selected variable. Size of the header is the same as number
+	// of cells for each row.
+	Header []string `protobuf:"bytes,1,rep,name=header,proto3" json:"header,omitempty"`
+	// A batch of query results, with each row containing cells corresponding to
+	// header variable order.
+	Rows []*QueryResponseRow `protobuf:"bytes,2,rep,name=rows,proto3" json:"rows,omitempty"`
+}
+
+func (x *QueryStreamResponse) Reset() {
+	*x = QueryStreamResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mixer_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryStreamResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryStreamResponse) ProtoMessage() {}
+
+func (x *QueryStreamResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mixer_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryStreamResponse.ProtoReflect.Descriptor instead.
+func (*QueryStreamResponse) Descriptor() ([]byte, []int) {
+	return file_mixer_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *QueryStreamResponse) GetHeader() []string {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *QueryStreamResponse) GetRows() []*QueryResponseRow {
+	if x != nil {
+		return x.Rows
+	}
+	return nil
+}
+
 // Request to translate a graph query.
 type TranslateRequest struct {
 	state         protoimpl.MessageState
@@ -267,12 +596,18 @@ type TranslateRequest struct {
 	SchemaMapping string `protobuf:"bytes,1,opt,name=schema_mapping,json=schemaMapping,proto3" json:"schema_mapping,omitempty"`
 	// String representation of sparql query.
 	Sparql string `protobuf:"bytes,2,opt,name=sparql,proto3" json:"sparql,omitempty"`
+	// Whether to explain how the query is translated. In explain mode, failures
+	// of the translation are reported in the plan instead of as an error.
+	Explain bool `protobuf:"varint,3,opt,name=explain,proto3" json:"explain,omitempty"`
+	// SQL dialect of the translated query, one of "bigquery" (default),
+	// "postgresql" and "sqlite".
+	Dialect string `protobuf:"bytes,4,opt,name=dialect,proto3" json:"dialect,omitempty"`
 }
 
 func (x *TranslateRequest) Reset() {
 	*x = TranslateRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixer_proto_msgTypes[4]
+		mi := &file_mixer_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -285,7 +620,7 @@ func (x *TranslateRequest) String() string {
 func (*TranslateRequest) ProtoMessage() {}
 
 func (x *TranslateRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_mixer_proto_msgTypes[4]
+	mi := &file_mixer_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -298,7 +633,7 @@ func (x *TranslateRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TranslateRequest.ProtoReflect.Descriptor instead.
 func (*TranslateRequest) Descriptor() ([]byte, []int) {
-	return file_mixer_proto_rawDescGZIP(), []int{4}
+	return file_mixer_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *TranslateRequest) GetSchemaMapping() string {
@@ -315,6 +650,20 @@ func (x *TranslateRequest) GetSparql() string {
 	return ""
 }
 
+func (x *TranslateRequest) GetExplain() bool {
+	if x != nil {
+		return x.Explain
+	}
+	return false
+}
+
+func (x *TranslateRequest) GetDialect() string {
+	if x != nil {
+		return x.Dialect
+	}
+	return ""
+}
+
 // Response of a translate request.
 type TranslateResponse struct {
 	state         protoimpl.MessageState
@@ -325,12 +674,14 @@ type TranslateResponse struct {
 	Sql string `protobuf:"bytes,1,opt,name=sql,proto3" json:"sql,omitempty"`
 	// Serialized json string of the translation result
 	Translation string `protobuf:"bytes,2,opt,name=translation,proto3" json:"translation,omitempty"`
+	// Serialized json string of the query plan, only set in explain mode.
+	Plan string `protobuf:"bytes,3,opt,name=plan,proto3" json:"plan,omitempty"`
 }
 
 func (x *TranslateResponse) Reset() {
 	*x = TranslateResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixer_proto_msgTypes[5]
+		mi := &file_mixer_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -343,7 +694,7 @@ func (x *TranslateResponse) String() string {
 func (*TranslateResponse) ProtoMessage() {}
 
 func (x *TranslateResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_mixer_proto_msgTypes[5]
+	mi := &file_mixer_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -356,7 +707,7 @@ func (x *TranslateResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TranslateResponse.ProtoReflect.Descriptor instead.
 func (*TranslateResponse) Descriptor() ([]byte, []int) {
-	return file_mixer_proto_rawDescGZIP(), []int{5}
+	return file_mixer_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *TranslateResponse) GetSql() string {
@@ -373,6 +724,13 @@ func (x *TranslateResponse) GetTranslation() string {
 	return ""
 }
 
+func (x *TranslateResponse) GetPlan() string {
+	if x != nil {
+		return x.Plan
+	}
+	return ""
+}
+
 // Request to get property labels.
 type GetPropertyLabelsRequest struct {
 	state         protoimpl.MessageState
@@ -386,7 +744,7 @@ type GetPropertyLabelsRequest struct {
 func (x *GetPropertyLabelsRequest) Reset() {
 	*x = GetPropertyLabelsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixer_proto_msgTypes[6]
+		mi := &file_mixer_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -399,7 +757,7 @@ func (x *GetPropertyLabelsRequest) String() string {
 func (*GetPropertyLabelsRequest) ProtoMessage() {}
 
 func (x *GetPropertyLabelsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_mixer_proto_msgTypes[6]
+	mi := &file_mixer_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -412,7 +770,7 @@ func (x *GetPropertyLabelsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetPropertyLabelsRequest.ProtoReflect.Descriptor instead.
 func (*GetPropertyLabelsRequest) Descriptor() ([]byte, []int) {
-	return file_mixer_proto_rawDescGZIP(), []int{6}
+	return file_mixer_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *GetPropertyLabelsRequest) GetDcids() []string {
@@ -435,7 +793,7 @@ type GetPropertyLabelsResponse struct {
 func (x *GetPropertyLabelsResponse) Reset() {
 	*x = GetPropertyLabelsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixer_proto_msgTypes[7]
+		mi := &file_mixer_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -448,7 +806,7 @@ func (x *GetPropertyLabelsResponse) String() string {
 func (*GetPropertyLabelsResponse) ProtoMessage() {}
 
 func (x *GetPropertyLabelsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_mixer_proto_msgTypes[7]
+	mi := &file_mixer_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -461,7 +819,7 @@ func (x *GetPropertyLabelsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetPropertyLabelsResponse.ProtoReflect.Descriptor instead.
 func (*GetPropertyLabelsResponse) Descriptor() ([]byte, []int) {
-	return file_mixer_proto_rawDescGZIP(), []int{7}
+	return file_mixer_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *GetPropertyLabelsResponse) GetPayload() string {
@@ -492,7 +850,7 @@ type GetPropertyValuesRequest struct {
 func (x *GetPropertyValuesRequest) Reset() {
 	*x = GetPropertyValuesRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixer_proto_msgTypes[8]
+		mi := &file_mixer_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -505,7 +863,7 @@ func (x *GetPropertyValuesRequest) String() string {
 func (*GetPropertyValuesRequest) ProtoMessage() {}
 
 func (x *GetPropertyValuesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_mixer_proto_msgTypes[8]
+	mi := &file_mixer_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -518,7 +876,7 @@ func (x *GetPropertyValuesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetPropertyValuesRequest.ProtoReflect.Descriptor instead.
 func (*GetPropertyValuesRequest) Descriptor() ([]byte, []int) {
-	return file_mixer_proto_rawDescGZIP(), []int{8}
+	return file_mixer_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *GetPropertyValuesRequest) GetDcids() []string {
@@ -569,7 +927,7 @@ type GetPropertyValuesResponse struct {
 func (x *GetPropertyValuesResponse) Reset() {
 	*x = GetPropertyValuesResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixer_proto_msgTypes[9]
+		mi := &file_mixer_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -582,7 +940,7 @@ func (x *GetPropertyValuesResponse) String() string {
 func (*GetPropertyValuesResponse) ProtoMessage() {}
 
 func (x *GetPropertyValuesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_mixer_proto_msgTypes[9]
+	mi := &file_mixer_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -595,7 +953,7 @@ func (x *GetPropertyValuesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetPropertyValuesResponse.ProtoReflect.Descriptor instead.
 func (*GetPropertyValuesResponse) Descriptor() ([]byte, []int) {
-	return file_mixer_proto_rawDescGZIP(), []int{9}
+	return file_mixer_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *GetPropertyValuesResponse) GetPayload() string {
@@ -620,7 +978,7 @@ type GetTriplesRequest struct {
 func (x *GetTriplesRequest) Reset() {
 	*x = GetTriplesRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixer_proto_msgTypes[10]
+		mi := &file_mixer_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -633,7 +991,7 @@ func (x *GetTriplesRequest) String() string {
 func (*GetTriplesRequest) ProtoMessage() {}
 
 func (x *GetTriplesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_mixer_proto_msgTypes[10]
+	mi := &file_mixer_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -646,7 +1004,7 @@ func (x *GetTriplesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetTriplesRequest.ProtoReflect.Descriptor instead.
 func (*GetTriplesRequest) Descriptor() ([]byte, []int) {
-	return file_mixer_proto_rawDescGZIP(), []int{10}
+	return file_mixer_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *GetTriplesRequest) GetDcids() []string {
@@ -676,7 +1034,7 @@ type GetTriplesResponse struct {
 func (x *GetTriplesResponse) Reset() {
 	*x = GetTriplesResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixer_proto_msgTypes[11]
+		mi := &file_mixer_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -689,7 +1047,7 @@ func (x *GetTriplesResponse) String() string {
 func (*GetTriplesResponse) ProtoMessage() {}
 
 func (x *GetTriplesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_mixer_proto_msgTypes[11]
+	mi := &file_mixer_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -702,7 +1060,7 @@ func (x *GetTriplesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetTriplesResponse.ProtoReflect.Descriptor instead.
 func (*GetTriplesResponse) Descriptor() ([]byte, []int) {
-	return file_mixer_proto_rawDescGZIP(), []int{11}
+	return file_mixer_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *GetTriplesResponse) GetPayload() string {
@@ -727,7 +1085,7 @@ type PropertyValue struct {
 func (x *PropertyValue) Reset() {
 	*x = PropertyValue{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixer_proto_msgTypes[12]
+		mi := &file_mixer_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -740,7 +1098,7 @@ func (x *PropertyValue) String() string {
 func (*PropertyValue) ProtoMessage() {}
 
 func (x *PropertyValue) ProtoReflect() protoreflect.Message {
-	mi := &file_mixer_proto_msgTypes[12]
+	mi := &file_mixer_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -753,7 +1111,7 @@ func (x *PropertyValue) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PropertyValue.ProtoReflect.Descriptor instead.
 func (*PropertyValue) Descriptor() ([]byte, []int) {
-	return file_mixer_proto_rawDescGZIP(), []int{12}
+	return file_mixer_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *PropertyValue) GetProperty() string {
@@ -787,7 +1145,7 @@ type GetPlaceObsRequest struct {
 func (x *GetPlaceObsRequest) Reset() {
 	*x = GetPlaceObsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixer_proto_msgTypes[13]
+		mi := &file_mixer_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -800,7 +1158,7 @@ func (x *GetPlaceObsRequest) String() string {
 func (*GetPlaceObsRequest) ProtoMessage() {}
 
 func (x *GetPlaceObsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_mixer_proto_msgTypes[13]
+	mi := &file_mixer_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -813,7 +1171,7 @@ func (x *GetPlaceObsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetPlaceObsRequest.ProtoReflect.Descriptor instead.
 func (*GetPlaceObsRequest) Descriptor() ([]byte, []int) {
-	return file_mixer_proto_rawDescGZIP(), []int{13}
+	return file_mixer_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *GetPlaceObsRequest) GetPlaceType() string {
@@ -852,7 +1210,7 @@ type GetPlacesInRequest struct {
 func (x *GetPlacesInRequest) Reset() {
 	*x = GetPlacesInRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixer_proto_msgTypes[14]
+		mi := &file_mixer_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -865,7 +1223,7 @@ func (x *GetPlacesInRequest) String() string {
 func (*GetPlacesInRequest) ProtoMessage() {}
 
 func (x *GetPlacesInRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_mixer_proto_msgTypes[14]
+	mi := &file_mixer_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -878,7 +1236,7 @@ func (x *GetPlacesInRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetPlacesInRequest.ProtoReflect.Descriptor instead.
 func (*GetPlacesInRequest) Descriptor() ([]byte, []int) {
-	return file_mixer_proto_rawDescGZIP(), []int{14}
+	return file_mixer_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *GetPlacesInRequest) GetDcids() []string {
@@ -908,7 +1266,7 @@ type GetPlacesInResponse struct {
 func (x *GetPlacesInResponse) Reset() {
 	*x = GetPlacesInResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixer_proto_msgTypes[15]
+		mi := &file_mixer_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -921,7 +1279,7 @@ func (x *GetPlacesInResponse) String() string {
 func (*GetPlacesInResponse) ProtoMessage() {}
 
 func (x *GetPlacesInResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_mixer_proto_msgTypes[15]
+	mi := &file_mixer_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -934,7 +1292,7 @@ func (x *GetPlacesInResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetPlacesInResponse.ProtoReflect.Descriptor instead.
 func (*GetPlacesInResponse) Descriptor() ([]byte, []int) {
-	return file_mixer_proto_rawDescGZIP(), []int{15}
+	return file_mixer_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *GetPlacesInResponse) GetPayload() string {
@@ -962,7 +1320,7 @@ type GetRelatedLocationsRequest struct {
 func (x *GetRelatedLocationsRequest) Reset() {
 	*x = GetRelatedLocationsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixer_proto_msgTypes[16]
+		mi := &file_mixer_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -975,7 +1333,7 @@ func (x *GetRelatedLocationsRequest) String() string {
 func (*GetRelatedLocationsRequest) ProtoMessage() {}
 
 func (x *GetRelatedLocationsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_mixer_proto_msgTypes[16]
+	mi := &file_mixer_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -988,7 +1346,7 @@ func (x *GetRelatedLocationsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetRelatedLocationsRequest.ProtoReflect.Descriptor instead.
 func (*GetRelatedLocationsRequest) Descriptor() ([]byte, []int) {
-	return file_mixer_proto_rawDescGZIP(), []int{16}
+	return file_mixer_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *GetRelatedLocationsRequest) GetDcid() string {
@@ -1037,7 +1395,7 @@ type GetLocationsRankingsRequest struct {
 func (x *GetLocationsRankingsRequest) Reset() {
 	*x = GetLocationsRankingsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixer_proto_msgTypes[17]
+		mi := &file_mixer_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1050,7 +1408,7 @@ func (x *GetLocationsRankingsRequest) String() string {
 func (*GetLocationsRankingsRequest) ProtoMessage() {}
 
 func (x *GetLocationsRankingsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_mixer_proto_msgTypes[17]
+	mi := &file_mixer_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1063,7 +1421,7 @@ func (x *GetLocationsRankingsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetLocationsRankingsRequest.ProtoReflect.Descriptor instead.
 func (*GetLocationsRankingsRequest) Descriptor() ([]byte, []int) {
-	return file_mixer_proto_rawDescGZIP(), []int{17}
+	return file_mixer_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *GetLocationsRankingsRequest) GetStatVarDcids() []string {
@@ -1105,7 +1463,7 @@ type GetLocationsRankingsResponse struct {
 func (x *GetLocationsRankingsResponse) Reset() {
 	*x = GetLocationsRankingsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixer_proto_msgTypes[18]
+		mi := &file_mixer_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1118,7 +1476,7 @@ func (x *GetLocationsRankingsResponse) String() string {
 func (*GetLocationsRankingsResponse) ProtoMessage() {}
 
 func (x *GetLocationsRankingsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_mixer_proto_msgTypes[18]
+	mi := &file_mixer_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1131,7 +1489,7 @@ func (x *GetLocationsRankingsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetLocationsRankingsResponse.ProtoReflect.Descriptor instead.
 func (*GetLocationsRankingsResponse) Descriptor() ([]byte, []int) {
-	return file_mixer_proto_rawDescGZIP(), []int{18}
+	return file_mixer_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *GetLocationsRankingsResponse) GetPayload() map[string]*RelatedPlacesInfo {
@@ -1154,7 +1512,7 @@ type GetRelatedLocationsResponse struct {
 func (x *GetRelatedLocationsResponse) Reset() {
 	*x = GetRelatedLocationsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixer_proto_msgTypes[19]
+		mi := &file_mixer_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1167,7 +1525,7 @@ func (x *GetRelatedLocationsResponse) String() string {
 func (*GetRelatedLocationsResponse) ProtoMessage() {}
 
 func (x *GetRelatedLocationsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_mixer_proto_msgTypes[19]
+	mi := &file_mixer_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1180,7 +1538,7 @@ func (x *GetRelatedLocationsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetRelatedLocationsResponse.ProtoReflect.Descriptor instead.
 func (*GetRelatedLocationsResponse) Descriptor() ([]byte, []int) {
-	return file_mixer_proto_rawDescGZIP(), []int{19}
+	return file_mixer_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *GetRelatedLocationsResponse) GetPayload() string {
@@ -1203,7 +1561,7 @@ type Place struct {
 func (x *Place) Reset() {
 	*x = Place{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixer_proto_msgTypes[20]
+		mi := &file_mixer_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1216,7 +1574,7 @@ func (x *Place) String() string {
 func (*Place) ProtoMessage() {}
 
 func (x *Place) ProtoReflect() protoreflect.Message {
-	mi := &file_mixer_proto_msgTypes[20]
+	mi := &file_mixer_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1229,7 +1587,7 @@ func (x *Place) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Place.ProtoReflect.Descriptor instead.
 func (*Place) Descriptor() ([]byte, []int) {
-	return file_mixer_proto_rawDescGZIP(), []int{20}
+	return file_mixer_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *Place) GetDcid() string {
@@ -1264,7 +1622,7 @@ type Places struct {
 func (x *Places) Reset() {
 	*x = Places{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixer_proto_msgTypes[21]
+		mi := &file_mixer_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1277,7 +1635,7 @@ func (x *Places) String() string {
 func (*Places) ProtoMessage() {}
 
 func (x *Places) ProtoReflect() protoreflect.Message {
-	mi := &file_mixer_proto_msgTypes[21]
+	mi := &file_mixer_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1290,7 +1648,7 @@ func (x *Places) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Places.ProtoReflect.Descriptor instead.
 func (*Places) Descriptor() ([]byte, []int) {
-	return file_mixer_proto_rawDescGZIP(), []int{21}
+	return file_mixer_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *Places) GetPlaces() []*Place {
@@ -1320,7 +1678,7 @@ type GetLandingPageDataRequest struct {
 func (x *GetLandingPageDataRequest) Reset() {
 	*x = GetLandingPageDataRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixer_proto_msgTypes[22]
+		mi := &file_mixer_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1333,7 +1691,7 @@ func (x *GetLandingPageDataRequest) String() string {
 func (*GetLandingPageDataRequest) ProtoMessage() {}
 
 func (x *GetLandingPageDataRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_mixer_proto_msgTypes[22]
+	mi := &file_mixer_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1346,7 +1704,7 @@ func (x *GetLandingPageDataRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetLandingPageDataRequest.ProtoReflect.Descriptor instead.
 func (*GetLandingPageDataRequest) Descriptor() ([]byte, []int) {
-	return file_mixer_proto_rawDescGZIP(), []int{22}
+	return file_mixer_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *GetLandingPageDataRequest) GetPlace() string {
@@ -1389,7 +1747,7 @@ type GetLandingPageDataResponse struct {
 func (x *GetLandingPageDataResponse) Reset() {
 	*x = GetLandingPageDataResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixer_proto_msgTypes[23]
+		mi := &file_mixer_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1402,7 +1760,7 @@ func (x *GetLandingPageDataResponse) String() string {
 func (*GetLandingPageDataResponse) ProtoMessage() {}
 
 func (x *GetLandingPageDataResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_mixer_proto_msgTypes[23]
+	mi := &file_mixer_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1415,7 +1773,7 @@ func (x *GetLandingPageDataResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetLandingPageDataResponse.ProtoReflect.Descriptor instead.
 func (*GetLandingPageDataResponse) Descriptor() ([]byte, []int) {
-	return file_mixer_proto_rawDescGZIP(), []int{23}
+	return file_mixer_proto_rawDescGZIP(), []int{25}
 }
 
 func (x *GetLandingPageDataResponse) GetStatVarSeries() map[string]*StatVarSeries {
@@ -1485,7 +1843,7 @@ type SearchRequest struct {
 func (x *SearchRequest) Reset() {
 	*x = SearchRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixer_proto_msgTypes[24]
+		mi := &file_mixer_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1498,7 +1856,7 @@ func (x *SearchRequest) String() string {
 func (*SearchRequest) ProtoMessage() {}
 
 func (x *SearchRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_mixer_proto_msgTypes[24]
+	mi := &file_mixer_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1511,7 +1869,7 @@ func (x *SearchRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SearchRequest.ProtoReflect.Descriptor instead.
 func (*SearchRequest) Descriptor() ([]byte, []int) {
-	return file_mixer_proto_rawDescGZIP(), []int{24}
+	return file_mixer_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *SearchRequest) GetQuery() string {
@@ -1541,7 +1899,7 @@ type SearchResponse struct {
 func (x *SearchResponse) Reset() {
 	*x = SearchResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixer_proto_msgTypes[25]
+		mi := &file_mixer_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1554,7 +1912,7 @@ func (x *SearchResponse) String() string {
 func (*SearchResponse) ProtoMessage() {}
 
 func (x *SearchResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_mixer_proto_msgTypes[25]
+	mi := &file_mixer_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1567,7 +1925,7 @@ func (x *SearchResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SearchResponse.ProtoReflect.Descriptor instead.
 func (*SearchResponse) Descriptor() ([]byte, []int) {
-	return file_mixer_proto_rawDescGZIP(), []int{25}
+	return file_mixer_proto_rawDescGZIP(), []int{27}
 }
 
 func (x *SearchResponse) GetSection() []*SearchResultSection {
@@ -1587,7 +1945,7 @@ type GetVersionRequest struct {
 func (x *GetVersionRequest) Reset() {
 	*x = GetVersionRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixer_proto_msgTypes[26]
+		mi := &file_mixer_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1600,7 +1958,7 @@ func (x *GetVersionRequest) String() string {
 func (*GetVersionRequest) ProtoMessage() {}
 
 func (x *GetVersionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_mixer_proto_msgTypes[26]
+	mi := &file_mixer_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1613,7 +1971,7 @@ func (x *GetVersionRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetVersionRequest.ProtoReflect.Descriptor instead.
 func (*GetVersionRequest) Descriptor() ([]byte, []int) {
-	return file_mixer_proto_rawDescGZIP(), []int{26}
+	return file_mixer_proto_rawDescGZIP(), []int{28}
 }
 
 // Get version response.
@@ -1630,12 +1988,16 @@ type GetVersionResponse struct {
 	BigTable string `protobuf:"bytes,3,opt,name=big_table,json=bigTable,proto3" json:"big_table,omitempty"`
 	// Github commit hash
 	GitHash string `protobuf:"bytes,4,opt,name=git_hash,json=gitHash,proto3" json:"git_hash,omitempty"`
+	// Active table of the default branch cache
+	BranchTable string `protobuf:"bytes,5,opt,name=branch_table,json=branchTable,proto3" json:"branch_table,omitempty"`
+	// Previous table of the default branch cache, kept for rollback
+	PreviousBranchTable string `protobuf:"bytes,6,opt,name=previous_branch_table,json=previousBranchTable,proto3" json:"previous_branch_table,omitempty"`
 }
 
 func (x *GetVersionResponse) Reset() {
 	*x = GetVersionResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixer_proto_msgTypes[27]
+		mi := &file_mixer_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1648,7 +2010,7 @@ func (x *GetVersionResponse) String() string {
 func (*GetVersionResponse) ProtoMessage() {}
 
 func (x *GetVersionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_mixer_proto_msgTypes[27]
+	mi := &file_mixer_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1661,7 +2023,7 @@ func (x *GetVersionResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetVersionResponse.ProtoReflect.Descriptor instead.
 func (*GetVersionResponse) Descriptor() ([]byte, []int) {
-	return file_mixer_proto_rawDescGZIP(), []int{27}
+	return file_mixer_proto_rawDescGZIP(), []int{29}
 }
 
 func (x *GetVersionResponse) GetStore() string {
@@ -1692,6 +2054,20 @@ func (x *GetVersionResponse) GetGitHash() string {
 	return ""
 }
 
+func (x *GetVersionResponse) GetBranchTable() string {
+	if x != nil {
+		return x.BranchTable
+	}
+	return ""
+}
+
+func (x *GetVersionResponse) GetPreviousBranchTable() string {
+	if x != nil {
+		return x.PreviousBranchTable
+	}
+	return ""
+}
+
 // Wrapper for all entities returned from search which belong to a single type.
 type SearchResultSection struct {
 	state         protoimpl.MessageState
@@ -1707,7 +2083,7 @@ type SearchResultSection struct {
 func (x *SearchResultSection) Reset() {
 	*x = SearchResultSection{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixer_proto_msgTypes[28]
+		mi := &file_mixer_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1720,7 +2096,7 @@ func (x *SearchResultSection) String() string {
 func (*SearchResultSection) ProtoMessage() {}
 
 func (x *SearchResultSection) ProtoReflect() protoreflect.Message {
-	mi := &file_mixer_proto_msgTypes[28]
+	mi := &file_mixer_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1733,7 +2109,7 @@ func (x *SearchResultSection) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SearchResultSection.ProtoReflect.Descriptor instead.
 func (*SearchResultSection) Descriptor() ([]byte, []int) {
-	return file_mixer_proto_rawDescGZIP(), []int{28}
+	return file_mixer_proto_rawDescGZIP(), []int{30}
 }
 
 func (x *SearchResultSection) GetTypeName() string {
@@ -1765,7 +2141,7 @@ type SearchEntityResult struct {
 func (x *SearchEntityResult) Reset() {
 	*x = SearchEntityResult{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixer_proto_msgTypes[29]
+		mi := &file_mixer_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1778,7 +2154,7 @@ func (x *SearchEntityResult) String() string {
 func (*SearchEntityResult) ProtoMessage() {}
 
 func (x *SearchEntityResult) ProtoReflect() protoreflect.Message {
-	mi := &file_mixer_proto_msgTypes[29]
+	mi := &file_mixer_proto_msgTypes[31]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1791,7 +2167,7 @@ func (x *SearchEntityResult) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SearchEntityResult.ProtoReflect.Descriptor instead.
 func (*SearchEntityResult) Descriptor() ([]byte, []int) {
-	return file_mixer_proto_rawDescGZIP(), []int{29}
+	return file_mixer_proto_rawDescGZIP(), []int{31}
 }
 
 func (x *SearchEntityResult) GetDcid() string {
@@ -1820,7 +2196,7 @@ type StatsVars struct {
 func (x *StatsVars) Reset() {
 	*x = StatsVars{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixer_proto_msgTypes[30]
+		mi := &file_mixer_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1833,7 +2209,7 @@ func (x *StatsVars) String() string {
 func (*StatsVars) ProtoMessage() {}
 
 func (x *StatsVars) ProtoReflect() protoreflect.Message {
-	mi := &file_mixer_proto_msgTypes[30]
+	mi := &file_mixer_proto_msgTypes[32]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1846,7 +2222,7 @@ func (x *StatsVars) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StatsVars.ProtoReflect.Descriptor instead.
 func (*StatsVars) Descriptor() ([]byte, []int) {
-	return file_mixer_proto_rawDescGZIP(), []int{30}
+	return file_mixer_proto_rawDescGZIP(), []int{32}
 }
 
 func (x *StatsVars) GetStatsVars() []string {
@@ -1869,7 +2245,7 @@ type GetPlaceStatsVarRequest struct {
 func (x *GetPlaceStatsVarRequest) Reset() {
 	*x = GetPlaceStatsVarRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixer_proto_msgTypes[31]
+		mi := &file_mixer_proto_msgTypes[33]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1882,7 +2258,7 @@ func (x *GetPlaceStatsVarRequest) String() string {
 func (*GetPlaceStatsVarRequest) ProtoMessage() {}
 
 func (x *GetPlaceStatsVarRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_mixer_proto_msgTypes[31]
+	mi := &file_mixer_proto_msgTypes[33]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1895,7 +2271,7 @@ func (x *GetPlaceStatsVarRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetPlaceStatsVarRequest.ProtoReflect.Descriptor instead.
 func (*GetPlaceStatsVarRequest) Descriptor() ([]byte, []int) {
-	return file_mixer_proto_rawDescGZIP(), []int{31}
+	return file_mixer_proto_rawDescGZIP(), []int{33}
 }
 
 func (x *GetPlaceStatsVarRequest) GetDcids() []string {
@@ -1918,7 +2294,7 @@ type GetPlaceStatsVarResponse struct {
 func (x *GetPlaceStatsVarResponse) Reset() {
 	*x = GetPlaceStatsVarResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixer_proto_msgTypes[32]
+		mi := &file_mixer_proto_msgTypes[34]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1931,7 +2307,7 @@ func (x *GetPlaceStatsVarResponse) String() string {
 func (*GetPlaceStatsVarResponse) ProtoMessage() {}
 
 func (x *GetPlaceStatsVarResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_mixer_proto_msgTypes[32]
+	mi := &file_mixer_proto_msgTypes[34]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1944,7 +2320,7 @@ func (x *GetPlaceStatsVarResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetPlaceStatsVarResponse.ProtoReflect.Descriptor instead.
 func (*GetPlaceStatsVarResponse) Descriptor() ([]byte, []int) {
-	return file_mixer_proto_rawDescGZIP(), []int{32}
+	return file_mixer_proto_rawDescGZIP(), []int{34}
 }
 
 func (x *GetPlaceStatsVarResponse) GetPlaces() map[string]*StatsVars {
@@ -1966,7 +2342,7 @@ type StatVars struct {
 func (x *StatVars) Reset() {
 	*x = StatVars{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixer_proto_msgTypes[33]
+		mi := &file_mixer_proto_msgTypes[35]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1979,7 +2355,7 @@ func (x *StatVars) String() string {
 func (*StatVars) ProtoMessage() {}
 
 func (x *StatVars) ProtoReflect() protoreflect.Message {
-	mi := &file_mixer_proto_msgTypes[33]
+	mi := &file_mixer_proto_msgTypes[35]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1992,7 +2368,7 @@ func (x *StatVars) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StatVars.ProtoReflect.Descriptor instead.
 func (*StatVars) Descriptor() ([]byte, []int) {
-	return file_mixer_proto_rawDescGZIP(), []int{33}
+	return file_mixer_proto_rawDescGZIP(), []int{35}
 }
 
 func (x *StatVars) GetStatVars() []string {
@@ -2015,7 +2391,7 @@ type GetPlaceStatVarsRequest struct {
 func (x *GetPlaceStatVarsRequest) Reset() {
 	*x = GetPlaceStatVarsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixer_proto_msgTypes[34]
+		mi := &file_mixer_proto_msgTypes[36]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2028,7 +2404,7 @@ func (x *GetPlaceStatVarsRequest) String() string {
 func (*GetPlaceStatVarsRequest) ProtoMessage() {}
 
 func (x *GetPlaceStatVarsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_mixer_proto_msgTypes[34]
+	mi := &file_mixer_proto_msgTypes[36]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2041,7 +2417,7 @@ func (x *GetPlaceStatVarsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetPlaceStatVarsRequest.ProtoReflect.Descriptor instead.
 func (*GetPlaceStatVarsRequest) Descriptor() ([]byte, []int) {
-	return file_mixer_proto_rawDescGZIP(), []int{34}
+	return file_mixer_proto_rawDescGZIP(), []int{36}
 }
 
 func (x *GetPlaceStatVarsRequest) GetDcids() []string {
@@ -2064,7 +2440,7 @@ type GetPlaceStatVarsResponse struct {
 func (x *GetPlaceStatVarsResponse) Reset() {
 	*x = GetPlaceStatVarsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixer_proto_msgTypes[35]
+		mi := &file_mixer_proto_msgTypes[37]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2077,7 +2453,7 @@ func (x *GetPlaceStatVarsResponse) String() string {
 func (*GetPlaceStatVarsResponse) ProtoMessage() {}
 
 func (x *GetPlaceStatVarsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_mixer_proto_msgTypes[35]
+	mi := &file_mixer_proto_msgTypes[37]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2090,7 +2466,7 @@ func (x *GetPlaceStatVarsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetPlaceStatVarsResponse.ProtoReflect.Descriptor instead.
 func (*GetPlaceStatVarsResponse) Descriptor() ([]byte, []int) {
-	return file_mixer_proto_rawDescGZIP(), []int{35}
+	return file_mixer_proto_rawDescGZIP(), []int{37}
 }
 
 func (x *GetPlaceStatVarsResponse) GetPlaces() map[string]*StatVars {
@@ -2113,7 +2489,7 @@ type GetPlaceStatVarsUnionRequest struct {
 func (x *GetPlaceStatVarsUnionRequest) Reset() {
 	*x = GetPlaceStatVarsUnionRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixer_proto_msgTypes[36]
+		mi := &file_mixer_proto_msgTypes[38]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2126,7 +2502,7 @@ func (x *GetPlaceStatVarsUnionRequest) String() string {
 func (*GetPlaceStatVarsUnionRequest) ProtoMessage() {}
 
 func (x *GetPlaceStatVarsUnionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_mixer_proto_msgTypes[36]
+	mi := &file_mixer_proto_msgTypes[38]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2139,7 +2515,7 @@ func (x *GetPlaceStatVarsUnionRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetPlaceStatVarsUnionRequest.ProtoReflect.Descriptor instead.
 func (*GetPlaceStatVarsUnionRequest) Descriptor() ([]byte, []int) {
-	return file_mixer_proto_rawDescGZIP(), []int{36}
+	return file_mixer_proto_rawDescGZIP(), []int{38}
 }
 
 func (x *GetPlaceStatVarsUnionRequest) GetDcids() []string {
@@ -2162,7 +2538,7 @@ type GetPlaceStatVarsUnionResponse struct {
 func (x *GetPlaceStatVarsUnionResponse) Reset() {
 	*x = GetPlaceStatVarsUnionResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixer_proto_msgTypes[37]
+		mi := &file_mixer_proto_msgTypes[39]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2175,7 +2551,7 @@ func (x *GetPlaceStatVarsUnionResponse) String() string {
 func (*GetPlaceStatVarsUnionResponse) ProtoMessage() {}
 
 func (x *GetPlaceStatVarsUnionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_mixer_proto_msgTypes[37]
+	mi := &file_mixer_proto_msgTypes[39]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2188,7 +2564,7 @@ func (x *GetPlaceStatVarsUnionResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetPlaceStatVarsUnionResponse.ProtoReflect.Descriptor instead.
 func (*GetPlaceStatVarsUnionResponse) Descriptor() ([]byte, []int) {
-	return file_mixer_proto_rawDescGZIP(), []int{37}
+	return file_mixer_proto_rawDescGZIP(), []int{39}
 }
 
 func (x *GetPlaceStatVarsUnionResponse) GetStatVars() *StatVars {
@@ -2210,7 +2586,7 @@ type GetPlaceStatVarsUnionResponseV1 struct {
 func (x *GetPlaceStatVarsUnionResponseV1) Reset() {
 	*x = GetPlaceStatVarsUnionResponseV1{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixer_proto_msgTypes[38]
+		mi := &file_mixer_proto_msgTypes[40]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2223,7 +2599,7 @@ func (x *GetPlaceStatVarsUnionResponseV1) String() string {
 func (*GetPlaceStatVarsUnionResponseV1) ProtoMessage() {}
 
 func (x *GetPlaceStatVarsUnionResponseV1) ProtoReflect() protoreflect.Message {
-	mi := &file_mixer_proto_msgTypes[38]
+	mi := &file_mixer_proto_msgTypes[40]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2236,7 +2612,7 @@ func (x *GetPlaceStatVarsUnionResponseV1) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetPlaceStatVarsUnionResponseV1.ProtoReflect.Descriptor instead.
 func (*GetPlaceStatVarsUnionResponseV1) Descriptor() ([]byte, []int) {
-	return file_mixer_proto_rawDescGZIP(), []int{38}
+	return file_mixer_proto_rawDescGZIP(), []int{40}
 }
 
 func (x *GetPlaceStatVarsUnionResponseV1) GetStatVars() []string {
@@ -2258,7 +2634,7 @@ type StatVarGroups struct {
 func (x *StatVarGroups) Reset() {
 	*x = StatVarGroups{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixer_proto_msgTypes[39]
+		mi := &file_mixer_proto_msgTypes[41]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2271,7 +2647,7 @@ func (x *StatVarGroups) String() string {
 func (*StatVarGroups) ProtoMessage() {}
 
 func (x *StatVarGroups) ProtoReflect() protoreflect.Message {
-	mi := &file_mixer_proto_msgTypes[39]
+	mi := &file_mixer_proto_msgTypes[41]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2284,7 +2660,7 @@ func (x *StatVarGroups) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StatVarGroups.ProtoReflect.Descriptor instead.
 func (*StatVarGroups) Descriptor() ([]byte, []int) {
-	return file_mixer_proto_rawDescGZIP(), []int{39}
+	return file_mixer_proto_rawDescGZIP(), []int{41}
 }
 
 func (x *StatVarGroups) GetStatVarGroups() map[string]*StatVarGroupNode {
@@ -2315,7 +2691,7 @@ type StatVarGroupNode struct {
 func (x *StatVarGroupNode) Reset() {
 	*x = StatVarGroupNode{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixer_proto_msgTypes[40]
+		mi := &file_mixer_proto_msgTypes[42]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2328,7 +2704,7 @@ func (x *StatVarGroupNode) String() string {
 func (*StatVarGroupNode) ProtoMessage() {}
 
 func (x *StatVarGroupNode) ProtoReflect() protoreflect.Message {
-	mi := &file_mixer_proto_msgTypes[40]
+	mi := &file_mixer_proto_msgTypes[42]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2341,7 +2717,7 @@ func (x *StatVarGroupNode) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StatVarGroupNode.ProtoReflect.Descriptor instead.
 func (*StatVarGroupNode) Descriptor() ([]byte, []int) {
-	return file_mixer_proto_rawDescGZIP(), []int{40}
+	return file_mixer_proto_rawDescGZIP(), []int{42}
 }
 
 func (x *StatVarGroupNode) GetAbsoluteName() string {
@@ -2390,7 +2766,7 @@ type GetStatVarGroupRequest struct {
 func (x *GetStatVarGroupRequest) Reset() {
 	*x = GetStatVarGroupRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixer_proto_msgTypes[41]
+		mi := &file_mixer_proto_msgTypes[43]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2403,7 +2779,7 @@ func (x *GetStatVarGroupRequest) String() string {
 func (*GetStatVarGroupRequest) ProtoMessage() {}
 
 func (x *GetStatVarGroupRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_mixer_proto_msgTypes[41]
+	mi := &file_mixer_proto_msgTypes[43]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2416,7 +2792,7 @@ func (x *GetStatVarGroupRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetStatVarGroupRequest.ProtoReflect.Descriptor instead.
 func (*GetStatVarGroupRequest) Descriptor() ([]byte, []int) {
-	return file_mixer_proto_rawDescGZIP(), []int{41}
+	return file_mixer_proto_rawDescGZIP(), []int{43}
 }
 
 func (x *GetStatVarGroupRequest) GetPlaces() []string {
@@ -2444,7 +2820,7 @@ type GetStatVarGroupNodeRequest struct {
 func (x *GetStatVarGroupNodeRequest) Reset() {
 	*x = GetStatVarGroupNodeRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixer_proto_msgTypes[42]
+		mi := &file_mixer_proto_msgTypes[44]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2457,7 +2833,7 @@ func (x *GetStatVarGroupNodeRequest) String() string {
 func (*GetStatVarGroupNodeRequest) ProtoMessage() {}
 
 func (x *GetStatVarGroupNodeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_mixer_proto_msgTypes[42]
+	mi := &file_mixer_proto_msgTypes[44]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2470,7 +2846,7 @@ func (x *GetStatVarGroupNodeRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetStatVarGroupNodeRequest.ProtoReflect.Descriptor instead.
 func (*GetStatVarGroupNodeRequest) Descriptor() ([]byte, []int) {
-	return file_mixer_proto_rawDescGZIP(), []int{42}
+	return file_mixer_proto_rawDescGZIP(), []int{44}
 }
 
 func (x *GetStatVarGroupNodeRequest) GetStatVarGroup() string {
@@ -2515,7 +2891,7 @@ type SVOPlace struct {
 func (x *SVOPlace) Reset() {
 	*x = SVOPlace{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixer_proto_msgTypes[43]
+		mi := &file_mixer_proto_msgTypes[45]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2528,7 +2904,7 @@ func (x *SVOPlace) String() string {
 func (*SVOPlace) ProtoMessage() {}
 
 func (x *SVOPlace) ProtoReflect() protoreflect.Message {
-	mi := &file_mixer_proto_msgTypes[43]
+	mi := &file_mixer_proto_msgTypes[45]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2541,7 +2917,7 @@ func (x *SVOPlace) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SVOPlace.ProtoReflect.Descriptor instead.
 func (*SVOPlace) Descriptor() ([]byte, []int) {
-	return file_mixer_proto_rawDescGZIP(), []int{43}
+	return file_mixer_proto_rawDescGZIP(), []int{45}
 }
 
 func (x *SVOPlace) GetName() string {
@@ -2611,7 +2987,7 @@ type SVOObservation struct {
 func (x *SVOObservation) Reset() {
 	*x = SVOObservation{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixer_proto_msgTypes[44]
+		mi := &file_mixer_proto_msgTypes[46]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2624,7 +3000,7 @@ func (x *SVOObservation) String() string {
 func (*SVOObservation) ProtoMessage() {}
 
 func (x *SVOObservation) ProtoReflect() protoreflect.Message {
-	mi := &file_mixer_proto_msgTypes[44]
+	mi := &file_mixer_proto_msgTypes[46]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2637,7 +3013,7 @@ func (x *SVOObservation) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SVOObservation.ProtoReflect.Descriptor instead.
 func (*SVOObservation) Descriptor() ([]byte, []int) {
-	return file_mixer_proto_rawDescGZIP(), []int{44}
+	return file_mixer_proto_rawDescGZIP(), []int{46}
 }
 
 func (x *SVOObservation) GetDcid() string {
@@ -2747,7 +3123,7 @@ type SVOCollection struct {
 func (x *SVOCollection) Reset() {
 	*x = SVOCollection{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixer_proto_msgTypes[45]
+		mi := &file_mixer_proto_msgTypes[47]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2760,7 +3136,7 @@ func (x *SVOCollection) String() string {
 func (*SVOCollection) ProtoMessage() {}
 
 func (x *SVOCollection) ProtoReflect() protoreflect.Message {
-	mi := &file_mixer_proto_msgTypes[45]
+	mi := &file_mixer_proto_msgTypes[47]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2773,7 +3149,7 @@ func (x *SVOCollection) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SVOCollection.ProtoReflect.Descriptor instead.
 func (*SVOCollection) Descriptor() ([]byte, []int) {
-	return file_mixer_proto_rawDescGZIP(), []int{45}
+	return file_mixer_proto_rawDescGZIP(), []int{47}
 }
 
 func (x *SVOCollection) GetPlaces() []*SVOPlace {
@@ -2799,7 +3175,7 @@ type EntityInfo struct {
 func (x *EntityInfo) Reset() {
 	*x = EntityInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixer_proto_msgTypes[46]
+		mi := &file_mixer_proto_msgTypes[48]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2812,7 +3188,7 @@ func (x *EntityInfo) String() string {
 func (*EntityInfo) ProtoMessage() {}
 
 func (x *EntityInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_mixer_proto_msgTypes[46]
+	mi := &file_mixer_proto_msgTypes[48]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2825,7 +3201,7 @@ func (x *EntityInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EntityInfo.ProtoReflect.Descriptor instead.
 func (*EntityInfo) Descriptor() ([]byte, []int) {
-	return file_mixer_proto_rawDescGZIP(), []int{46}
+	return file_mixer_proto_rawDescGZIP(), []int{48}
 }
 
 func (x *EntityInfo) GetName() string {
@@ -2876,7 +3252,7 @@ type EntityInfoCollection struct {
 func (x *EntityInfoCollection) Reset() {
 	*x = EntityInfoCollection{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixer_proto_msgTypes[47]
+		mi := &file_mixer_proto_msgTypes[49]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2889,7 +3265,7 @@ func (x *EntityInfoCollection) String() string {
 func (*EntityInfoCollection) ProtoMessage() {}
 
 func (x *EntityInfoCollection) ProtoReflect() protoreflect.Message {
-	mi := &file_mixer_proto_msgTypes[47]
+	mi := &file_mixer_proto_msgTypes[49]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2902,7 +3278,7 @@ func (x *EntityInfoCollection) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EntityInfoCollection.ProtoReflect.Descriptor instead.
 func (*EntityInfoCollection) Descriptor() ([]byte, []int) {
-	return file_mixer_proto_rawDescGZIP(), []int{47}
+	return file_mixer_proto_rawDescGZIP(), []int{49}
 }
 
 func (x *EntityInfoCollection) GetEntities() []*EntityInfo {
@@ -2934,7 +3310,7 @@ type ContainedInPlaceRelation struct {
 func (x *ContainedInPlaceRelation) Reset() {
 	*x = ContainedInPlaceRelation{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixer_proto_msgTypes[48]
+		mi := &file_mixer_proto_msgTypes[50]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2947,7 +3323,7 @@ func (x *ContainedInPlaceRelation) String() string {
 func (*ContainedInPlaceRelation) ProtoMessage() {}
 
 func (x *ContainedInPlaceRelation) ProtoReflect() protoreflect.Message {
-	mi := &file_mixer_proto_msgTypes[48]
+	mi := &file_mixer_proto_msgTypes[50]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2960,7 +3336,7 @@ func (x *ContainedInPlaceRelation) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ContainedInPlaceRelation.ProtoReflect.Descriptor instead.
 func (*ContainedInPlaceRelation) Descriptor() ([]byte, []int) {
-	return file_mixer_proto_rawDescGZIP(), []int{48}
+	return file_mixer_proto_rawDescGZIP(), []int{50}
 }
 
 func (x *ContainedInPlaceRelation) GetParentId() string {
@@ -3011,7 +3387,7 @@ type Triple struct {
 func (x *Triple) Reset() {
 	*x = Triple{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixer_proto_msgTypes[49]
+		mi := &file_mixer_proto_msgTypes[51]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3024,7 +3400,7 @@ func (x *Triple) String() string {
 func (*Triple) ProtoMessage() {}
 
 func (x *Triple) ProtoReflect() protoreflect.Message {
-	mi := &file_mixer_proto_msgTypes[49]
+	mi := &file_mixer_proto_msgTypes[51]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3037,7 +3413,7 @@ func (x *Triple) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Triple.ProtoReflect.Descriptor instead.
 func (*Triple) Descriptor() ([]byte, []int) {
-	return file_mixer_proto_rawDescGZIP(), []int{49}
+	return file_mixer_proto_rawDescGZIP(), []int{51}
 }
 
 func (x *Triple) GetSubjectId() string {
@@ -3116,7 +3492,7 @@ type Triples struct {
 func (x *Triples) Reset() {
 	*x = Triples{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixer_proto_msgTypes[50]
+		mi := &file_mixer_proto_msgTypes[52]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3129,7 +3505,7 @@ func (x *Triples) String() string {
 func (*Triples) ProtoMessage() {}
 
 func (x *Triples) ProtoReflect() protoreflect.Message {
-	mi := &file_mixer_proto_msgTypes[50]
+	mi := &file_mixer_proto_msgTypes[52]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3142,7 +3518,7 @@ func (x *Triples) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Triples.ProtoReflect.Descriptor instead.
 func (*Triples) Descriptor() ([]byte, []int) {
-	return file_mixer_proto_rawDescGZIP(), []int{50}
+	return file_mixer_proto_rawDescGZIP(), []int{52}
 }
 
 func (x *Triples) GetTriples() []*Triple {
@@ -3174,7 +3550,7 @@ type ProvenanceInfo struct {
 func (x *ProvenanceInfo) Reset() {
 	*x = ProvenanceInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixer_proto_msgTypes[51]
+		mi := &file_mixer_proto_msgTypes[53]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3187,7 +3563,7 @@ func (x *ProvenanceInfo) String() string {
 func (*ProvenanceInfo) ProtoMessage() {}
 
 func (x *ProvenanceInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_mixer_proto_msgTypes[51]
+	mi := &file_mixer_proto_msgTypes[53]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3200,7 +3576,7 @@ func (x *ProvenanceInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProvenanceInfo.ProtoReflect.Descriptor instead.
 func (*ProvenanceInfo) Descriptor() ([]byte, []int) {
-	return file_mixer_proto_rawDescGZIP(), []int{51}
+	return file_mixer_proto_rawDescGZIP(), []int{53}
 }
 
 func (x *ProvenanceInfo) GetProvenanceId() string {
@@ -3236,7 +3612,7 @@ type Provenances struct {
 func (x *Provenances) Reset() {
 	*x = Provenances{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixer_proto_msgTypes[52]
+		mi := &file_mixer_proto_msgTypes[54]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3249,7 +3625,7 @@ func (x *Provenances) String() string {
 func (*Provenances) ProtoMessage() {}
 
 func (x *Provenances) ProtoReflect() protoreflect.Message {
-	mi := &file_mixer_proto_msgTypes[52]
+	mi := &file_mixer_proto_msgTypes[54]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3262,7 +3638,7 @@ func (x *Provenances) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Provenances.ProtoReflect.Descriptor instead.
 func (*Provenances) Descriptor() ([]byte, []int) {
-	return file_mixer_proto_rawDescGZIP(), []int{52}
+	return file_mixer_proto_rawDescGZIP(), []int{54}
 }
 
 func (x *Provenances) GetProvenances() []*ProvenanceInfo {
@@ -3285,7 +3661,7 @@ type PropertyLabels struct {
 func (x *PropertyLabels) Reset() {
 	*x = PropertyLabels{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixer_proto_msgTypes[53]
+		mi := &file_mixer_proto_msgTypes[55]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3298,7 +3674,7 @@ func (x *PropertyLabels) String() string {
 func (*PropertyLabels) ProtoMessage() {}
 
 func (x *PropertyLabels) ProtoReflect() protoreflect.Message {
-	mi := &file_mixer_proto_msgTypes[53]
+	mi := &file_mixer_proto_msgTypes[55]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3311,7 +3687,7 @@ func (x *PropertyLabels) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PropertyLabels.ProtoReflect.Descriptor instead.
 func (*PropertyLabels) Descriptor() ([]byte, []int) {
-	return file_mixer_proto_rawDescGZIP(), []int{53}
+	return file_mixer_proto_rawDescGZIP(), []int{55}
 }
 
 func (x *PropertyLabels) GetInLabels() []string {
@@ -3347,7 +3723,7 @@ type RelatedPlacesInfo struct {
 func (x *RelatedPlacesInfo) Reset() {
 	*x = RelatedPlacesInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixer_proto_msgTypes[54]
+		mi := &file_mixer_proto_msgTypes[56]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3360,7 +3736,7 @@ func (x *RelatedPlacesInfo) String() string {
 func (*RelatedPlacesInfo) ProtoMessage() {}
 
 func (x *RelatedPlacesInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_mixer_proto_msgTypes[54]
+	mi := &file_mixer_proto_msgTypes[56]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3373,7 +3749,7 @@ func (x *RelatedPlacesInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RelatedPlacesInfo.ProtoReflect.Descriptor instead.
 func (*RelatedPlacesInfo) Descriptor() ([]byte, []int) {
-	return file_mixer_proto_rawDescGZIP(), []int{54}
+	return file_mixer_proto_rawDescGZIP(), []int{56}
 }
 
 func (x *RelatedPlacesInfo) GetRelatedPlaces() []string {
@@ -3430,7 +3806,7 @@ type GetStatVarPathRequest struct {
 func (x *GetStatVarPathRequest) Reset() {
 	*x = GetStatVarPathRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixer_proto_msgTypes[55]
+		mi := &file_mixer_proto_msgTypes[57]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3443,7 +3819,7 @@ func (x *GetStatVarPathRequest) String() string {
 func (*GetStatVarPathRequest) ProtoMessage() {}
 
 func (x *GetStatVarPathRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_mixer_proto_msgTypes[55]
+	mi := &file_mixer_proto_msgTypes[57]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3456,7 +3832,7 @@ func (x *GetStatVarPathRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetStatVarPathRequest.ProtoReflect.Descriptor instead.
 func (*GetStatVarPathRequest) Descriptor() ([]byte, []int) {
-	return file_mixer_proto_rawDescGZIP(), []int{55}
+	return file_mixer_proto_rawDescGZIP(), []int{57}
 }
 
 func (x *GetStatVarPathRequest) GetId() string {
@@ -3482,7 +3858,7 @@ type GetStatVarPathResponse struct {
 func (x *GetStatVarPathResponse) Reset() {
 	*x = GetStatVarPathResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixer_proto_msgTypes[56]
+		mi := &file_mixer_proto_msgTypes[58]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3495,7 +3871,7 @@ func (x *GetStatVarPathResponse) String() string {
 func (*GetStatVarPathResponse) ProtoMessage() {}
 
 func (x *GetStatVarPathResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_mixer_proto_msgTypes[56]
+	mi := &file_mixer_proto_msgTypes[58]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3508,7 +3884,7 @@ func (x *GetStatVarPathResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetStatVarPathResponse.ProtoReflect.Descriptor instead.
 func (*GetStatVarPathResponse) Descriptor() ([]byte, []int) {
-	return file_mixer_proto_rawDescGZIP(), []int{56}
+	return file_mixer_proto_rawDescGZIP(), []int{58}
 }
 
 func (x *GetStatVarPathResponse) GetPath() []string {
@@ -3532,7 +3908,7 @@ type SearchStatVarRequest struct {
 func (x *SearchStatVarRequest) Reset() {
 	*x = SearchStatVarRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixer_proto_msgTypes[57]
+		mi := &file_mixer_proto_msgTypes[59]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3545,7 +3921,7 @@ func (x *SearchStatVarRequest) String() string {
 func (*SearchStatVarRequest) ProtoMessage() {}
 
 func (x *SearchStatVarRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_mixer_proto_msgTypes[57]
+	mi := &file_mixer_proto_msgTypes[59]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3558,7 +3934,7 @@ func (x *SearchStatVarRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SearchStatVarRequest.ProtoReflect.Descriptor instead.
 func (*SearchStatVarRequest) Descriptor() ([]byte, []int) {
-	return file_mixer_proto_rawDescGZIP(), []int{57}
+	return file_mixer_proto_rawDescGZIP(), []int{59}
 }
 
 func (x *SearchStatVarRequest) GetQuery() string {
@@ -3589,7 +3965,7 @@ type SearchStatVarResponse struct {
 func (x *SearchStatVarResponse) Reset() {
 	*x = SearchStatVarResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixer_proto_msgTypes[58]
+		mi := &file_mixer_proto_msgTypes[60]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3602,7 +3978,7 @@ func (x *SearchStatVarResponse) String() string {
 func (*SearchStatVarResponse) ProtoMessage() {}
 
 func (x *SearchStatVarResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_mixer_proto_msgTypes[58]
+	mi := &file_mixer_proto_msgTypes[60]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3615,7 +3991,7 @@ func (x *SearchStatVarResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SearchStatVarResponse.ProtoReflect.Descriptor instead.
 func (*SearchStatVarResponse) Descriptor() ([]byte, []int) {
-	return file_mixer_proto_rawDescGZIP(), []int{58}
+	return file_mixer_proto_rawDescGZIP(), []int{60}
 }
 
 func (x *SearchStatVarResponse) GetStatVars() []*EntityInfo {
@@ -3649,7 +4025,7 @@ type StatVarGroupNode_ChildSVG struct {
 func (x *StatVarGroupNode_ChildSVG) Reset() {
 	*x = StatVarGroupNode_ChildSVG{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixer_proto_msgTypes[65]
+		mi := &file_mixer_proto_msgTypes[67]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3662,7 +4038,7 @@ func (x *StatVarGroupNode_ChildSVG) String() string {
 func (*StatVarGroupNode_ChildSVG) ProtoMessage() {}
 
 func (x *StatVarGroupNode_ChildSVG) ProtoReflect() protoreflect.Message {
-	mi := &file_mixer_proto_msgTypes[65]
+	mi := &file_mixer_proto_msgTypes[67]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3675,7 +4051,7 @@ func (x *StatVarGroupNode_ChildSVG) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StatVarGroupNode_ChildSVG.ProtoReflect.Descriptor instead.
 func (*StatVarGroupNode_ChildSVG) Descriptor() ([]byte, []int) {
-	return file_mixer_proto_rawDescGZIP(), []int{40, 0}
+	return file_mixer_proto_rawDescGZIP(), []int{42, 0}
 }
 
 func (x *StatVarGroupNode_ChildSVG) GetId() string {
@@ -3715,7 +4091,7 @@ type StatVarGroupNode_ChildSV struct {
 func (x *StatVarGroupNode_ChildSV) Reset() {
 	*x = StatVarGroupNode_ChildSV{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixer_proto_msgTypes[66]
+		mi := &file_mixer_proto_msgTypes[68]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3728,7 +4104,7 @@ func (x *StatVarGroupNode_ChildSV) String() string {
 func (*StatVarGroupNode_ChildSV) ProtoMessage() {}
 
 func (x *StatVarGroupNode_ChildSV) ProtoReflect() protoreflect.Message {
-	mi := &file_mixer_proto_msgTypes[66]
+	mi := &file_mixer_proto_msgTypes[68]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3741,7 +4117,7 @@ func (x *StatVarGroupNode_ChildSV) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StatVarGroupNode_ChildSV.ProtoReflect.Descriptor instead.
 func (*StatVarGroupNode_ChildSV) Descriptor() ([]byte, []int) {
-	return file_mixer_proto_rawDescGZIP(), []int{40, 1}
+	return file_mixer_proto_rawDescGZIP(), []int{42, 1}
 }
 
 func (x *StatVarGroupNode_ChildSV) GetId() string {
@@ -3778,7 +4154,7 @@ type SVOPlace_Temp struct {
 func (x *SVOPlace_Temp) Reset() {
 	*x = SVOPlace_Temp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixer_proto_msgTypes[67]
+		mi := &file_mixer_proto_msgTypes[69]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3791,7 +4167,7 @@ func (x *SVOPlace_Temp) String() string {
 func (*SVOPlace_Temp) ProtoMessage() {}
 
 func (x *SVOPlace_Temp) ProtoReflect() protoreflect.Message {
-	mi := &file_mixer_proto_msgTypes[67]
+	mi := &file_mixer_proto_msgTypes[69]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3804,7 +4180,7 @@ func (x *SVOPlace_Temp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SVOPlace_Temp.ProtoReflect.Descriptor instead.
 func (*SVOPlace_Temp) Descriptor() ([]byte, []int) {
-	return file_mixer_proto_rawDescGZIP(), []int{43, 0}
+	return file_mixer_proto_rawDescGZIP(), []int{45, 0}
 }
 
 func (x *SVOPlace_Temp) GetChildPlaces() []string {
@@ -3835,7 +4211,7 @@ type SVOObservation_Temp struct {
 func (x *SVOObservation_Temp) Reset() {
 	*x = SVOObservation_Temp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixer_proto_msgTypes[68]
+		mi := &file_mixer_proto_msgTypes[70]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3848,7 +4224,7 @@ func (x *SVOObservation_Temp) String() string {
 func (*SVOObservation_Temp) ProtoMessage() {}
 
 func (x *SVOObservation_Temp) ProtoReflect() protoreflect.Message {
-	mi := &file_mixer_proto_msgTypes[68]
+	mi := &file_mixer_proto_msgTypes[70]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3861,7 +4237,7 @@ func (x *SVOObservation_Temp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SVOObservation_Temp.ProtoReflect.Descriptor instead.
 func (*SVOObservation_Temp) Descriptor() ([]byte, []int) {
-	return file_mixer_proto_rawDescGZIP(), []int{44, 0}
+	return file_mixer_proto_rawDescGZIP(), []int{46, 0}
 }
 
 func (x *SVOObservation_Temp) GetObservationAbout() string {
@@ -3896,7 +4272,7 @@ type RelatedPlacesInfo_Ranking struct {
 func (x *RelatedPlacesInfo_Ranking) Reset() {
 	*x = RelatedPlacesInfo_Ranking{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixer_proto_msgTypes[69]
+		mi := &file_mixer_proto_msgTypes[71]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3909,7 +4285,7 @@ func (x *RelatedPlacesInfo_Ranking) String() string {
 func (*RelatedPlacesInfo_Ranking) ProtoMessage() {}
 
 func (x *RelatedPlacesInfo_Ranking) ProtoReflect() protoreflect.Message {
-	mi := &file_mixer_proto_msgTypes[69]
+	mi := &file_mixer_proto_msgTypes[71]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3922,7 +4298,7 @@ func (x *RelatedPlacesInfo_Ranking) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RelatedPlacesInfo_Ranking.ProtoReflect.Descriptor instead.
 func (*RelatedPlacesInfo_Ranking) Descriptor() ([]byte, []int) {
-	return file_mixer_proto_rawDescGZIP(), []int{54, 0}
+	return file_mixer_proto_rawDescGZIP(), []int{56, 0}
 }
 
 func (x *RelatedPlacesInfo_Ranking) GetInfo() []*RelatedPlacesInfo_Ranking_RankInfo {
@@ -3945,7 +4321,7 @@ type RelatedPlacesInfo_Ranking_RankInfo struct {
 func (x *RelatedPlacesInfo_Ranking_RankInfo) Reset() {
 	*x = RelatedPlacesInfo_Ranking_RankInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mixer_proto_msgTypes[70]
+		mi := &file_mixer_proto_msgTypes[72]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3958,7 +4334,7 @@ func (x *RelatedPlacesInfo_Ranking_RankInfo) String() string {
 func (*RelatedPlacesInfo_Ranking_RankInfo) ProtoMessage() {}
 
 func (x *RelatedPlacesInfo_Ranking_RankInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_mixer_proto_msgTypes[70]
+	mi := &file_mixer_proto_msgTypes[72]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3971,7 +4347,7 @@ func (x *RelatedPlacesInfo_Ranking_RankInfo) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use RelatedPlacesInfo_Ranking_RankInfo.ProtoReflect.Descriptor instead.
 func (*RelatedPlacesInfo_Ranking_RankInfo) Descriptor() ([]byte, []int) {
-	return file_mixer_proto_rawDescGZIP(), []int{54, 0, 0}
+	return file_mixer_proto_rawDescGZIP(), []int{56, 0, 0}
 }
 
 func (x *RelatedPlacesInfo_Ranking_RankInfo) GetRank() int32 {
@@ -4002,729 +4378,798 @@ var file_mixer_proto_rawDesc = []byte{
 	0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x1a, 0x0a, 0x73, 0x74, 0x61, 0x74,
 	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61,
 	0x70, 0x69, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x22, 0x26, 0x0a, 0x0c, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x70, 0x61, 0x72, 0x71, 0x6c, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x70, 0x61, 0x72, 0x71, 0x6c, 0x22, 0x4e, 0x0a, 0x11,
-	0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x43, 0x65, 0x6c,
-	0x6c, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x70, 0x72, 0x6f, 0x76, 0x65,
-	0x6e, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c,
-	0x70, 0x72, 0x6f, 0x76, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x22, 0x48, 0x0a, 0x10,
-	0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x6f, 0x77,
-	0x12, 0x34, 0x0a, 0x05, 0x63, 0x65, 0x6c, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x1e, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x51, 0x75,
-	0x65, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x43, 0x65, 0x6c, 0x6c, 0x52,
-	0x05, 0x63, 0x65, 0x6c, 0x6c, 0x73, 0x22, 0x5a, 0x0a, 0x0d, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65,
-	0x72, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12,
-	0x31, 0x0a, 0x04, 0x72, 0x6f, 0x77, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e,
-	0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72,
-	0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x6f, 0x77, 0x52, 0x04, 0x72, 0x6f,
-	0x77, 0x73, 0x22, 0x51, 0x0a, 0x10, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74, 0x65, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61,
-	0x5f, 0x6d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d,
-	0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x12, 0x16, 0x0a,
-	0x06, 0x73, 0x70, 0x61, 0x72, 0x71, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73,
-	0x70, 0x61, 0x72, 0x71, 0x6c, 0x22, 0x47, 0x0a, 0x11, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6c, 0x61,
-	0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x71,
-	0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x73, 0x71, 0x6c, 0x12, 0x20, 0x0a, 0x0b,
-	0x74, 0x72, 0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0b, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x30,
-	0x0a, 0x18, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x4c, 0x61, 0x62,
-	0x65, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x63,
-	0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x64, 0x63, 0x69, 0x64, 0x73,
-	0x22, 0x35, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x4c,
-	0x61, 0x62, 0x65, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a,
-	0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
-	0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x22, 0x9f, 0x01, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x50,
-	0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x63, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20,
-	0x03, 0x28, 0x09, 0x52, 0x05, 0x64, 0x63, 0x69, 0x64, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x76, 0x61,
-	0x6c, 0x75, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
-	0x76, 0x61, 0x6c, 0x75, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f,
-	0x70, 0x65, 0x72, 0x74, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x6f,
-	0x70, 0x65, 0x72, 0x74, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x64,
-	0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
-	0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x35, 0x0a, 0x19, 0x47, 0x65, 0x74,
-	0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64,
-	0x22, 0x3f, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x54, 0x72, 0x69, 0x70, 0x6c, 0x65, 0x73, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x63, 0x69, 0x64, 0x73, 0x18, 0x01,
-	0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x64, 0x63, 0x69, 0x64, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x6c,
-	0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69,
-	0x74, 0x22, 0x2e, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x54, 0x72, 0x69, 0x70, 0x6c, 0x65, 0x73, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f,
-	0x61, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61,
-	0x64, 0x22, 0x41, 0x0a, 0x0d, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x56, 0x61, 0x6c,
-	0x75, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x12, 0x14,
-	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76,
-	0x61, 0x6c, 0x75, 0x65, 0x22, 0x62, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x63, 0x65,
-	0x4f, 0x62, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x6c,
-	0x61, 0x63, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
-	0x70, 0x6c, 0x61, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x73, 0x74, 0x61,
-	0x74, 0x5f, 0x76, 0x61, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x74, 0x61,
-	0x74, 0x56, 0x61, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x04, 0x64, 0x61, 0x74, 0x65, 0x22, 0x49, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x50,
-	0x6c, 0x61, 0x63, 0x65, 0x73, 0x49, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14,
-	0x0a, 0x05, 0x64, 0x63, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x64,
-	0x63, 0x69, 0x64, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x5f, 0x74, 0x79,
-	0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x54,
-	0x79, 0x70, 0x65, 0x22, 0x2f, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x73,
-	0x49, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61,
-	0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x61, 0x79,
-	0x6c, 0x6f, 0x61, 0x64, 0x22, 0x9d, 0x01, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x52, 0x65, 0x6c, 0x61,
-	0x74, 0x65, 0x64, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x63, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x04, 0x64, 0x63, 0x69, 0x64, 0x12, 0x24, 0x0a, 0x0e, 0x73, 0x74, 0x61, 0x74, 0x5f,
-	0x76, 0x61, 0x72, 0x5f, 0x64, 0x63, 0x69, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52,
-	0x0c, 0x73, 0x74, 0x61, 0x74, 0x56, 0x61, 0x72, 0x44, 0x63, 0x69, 0x64, 0x73, 0x12, 0x21, 0x0a,
-	0x0c, 0x77, 0x69, 0x74, 0x68, 0x69, 0x6e, 0x5f, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0b, 0x77, 0x69, 0x74, 0x68, 0x69, 0x6e, 0x50, 0x6c, 0x61, 0x63, 0x65,
-	0x12, 0x22, 0x0a, 0x0d, 0x69, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x63, 0x61, 0x70, 0x69, 0x74,
-	0x61, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x69, 0x73, 0x50, 0x65, 0x72, 0x43, 0x61,
-	0x70, 0x69, 0x74, 0x61, 0x22, 0xa9, 0x01, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x4c, 0x6f, 0x63, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x0e, 0x73, 0x74, 0x61, 0x74, 0x5f, 0x76, 0x61, 0x72,
-	0x5f, 0x64, 0x63, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x74,
-	0x61, 0x74, 0x56, 0x61, 0x72, 0x44, 0x63, 0x69, 0x64, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x6c,
-	0x61, 0x63, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
-	0x70, 0x6c, 0x61, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x77, 0x69, 0x74,
-	0x68, 0x69, 0x6e, 0x5f, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0b, 0x77, 0x69, 0x74, 0x68, 0x69, 0x6e, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x12, 0x22, 0x0a, 0x0d,
-	0x69, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x63, 0x61, 0x70, 0x69, 0x74, 0x61, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x0b, 0x69, 0x73, 0x50, 0x65, 0x72, 0x43, 0x61, 0x70, 0x69, 0x74, 0x61,
-	0x22, 0xcc, 0x01, 0x0a, 0x1c, 0x47, 0x65, 0x74, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x73, 0x52, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x50, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x01, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x36, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73,
-	0x2e, 0x47, 0x65, 0x74, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x61, 0x6e,
-	0x6b, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x50, 0x61,
-	0x79, 0x6c, 0x6f, 0x61, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6c,
-	0x6f, 0x61, 0x64, 0x1a, 0x5a, 0x0a, 0x0c, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x45, 0x6e,
-	0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x34, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f,
-	0x6e, 0x73, 0x2e, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x65, 0x64, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x73,
-	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22,
-	0x37, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x65, 0x64, 0x4c, 0x6f, 0x63,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18,
-	0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x22, 0x41, 0x0a, 0x05, 0x50, 0x6c, 0x61, 0x63,
-	0x65, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x63, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x04, 0x64, 0x63, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x70, 0x6f, 0x70,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x70, 0x6f, 0x70, 0x22, 0x34, 0x0a, 0x06, 0x50,
-	0x6c, 0x61, 0x63, 0x65, 0x73, 0x12, 0x2a, 0x0a, 0x06, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x73, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d,
-	0x6f, 0x6e, 0x73, 0x2e, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x52, 0x06, 0x70, 0x6c, 0x61, 0x63, 0x65,
-	0x73, 0x22, 0x6f, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x4c, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x50,
-	0x61, 0x67, 0x65, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14,
-	0x0a, 0x05, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x70,
-	0x6c, 0x61, 0x63, 0x65, 0x12, 0x22, 0x0a, 0x0d, 0x6e, 0x65, 0x77, 0x5f, 0x73, 0x74, 0x61, 0x74,
-	0x5f, 0x76, 0x61, 0x72, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x6e, 0x65, 0x77,
-	0x53, 0x74, 0x61, 0x74, 0x56, 0x61, 0x72, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x65, 0x65, 0x64,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x73, 0x65, 0x65, 0x64, 0x4a, 0x04, 0x08, 0x02,
-	0x10, 0x03, 0x22, 0xdd, 0x04, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x4c, 0x61, 0x6e, 0x64, 0x69, 0x6e,
-	0x67, 0x50, 0x61, 0x67, 0x65, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x62, 0x0a, 0x0f, 0x73, 0x74, 0x61, 0x74, 0x5f, 0x76, 0x61, 0x72, 0x5f, 0x73, 0x65,
-	0x72, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3a, 0x2e, 0x64, 0x61, 0x74,
-	0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x61, 0x6e, 0x64,
-	0x69, 0x6e, 0x67, 0x50, 0x61, 0x67, 0x65, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x56, 0x61, 0x72, 0x53, 0x65, 0x72, 0x69, 0x65,
-	0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0d, 0x73, 0x74, 0x61, 0x74, 0x56, 0x61, 0x72, 0x53,
-	0x65, 0x72, 0x69, 0x65, 0x73, 0x12, 0x65, 0x0a, 0x10, 0x61, 0x6c, 0x6c, 0x5f, 0x63, 0x68, 0x69,
-	0x6c, 0x64, 0x5f, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x3b, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x47, 0x65,
-	0x74, 0x4c, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x50, 0x61, 0x67, 0x65, 0x44, 0x61, 0x74, 0x61,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x41, 0x6c, 0x6c, 0x43, 0x68, 0x69, 0x6c,
-	0x64, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0e, 0x61, 0x6c,
-	0x6c, 0x43, 0x68, 0x69, 0x6c, 0x64, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x73, 0x12, 0x2a, 0x0a, 0x11,
-	0x63, 0x68, 0x69, 0x6c, 0x64, 0x5f, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x73, 0x5f, 0x74, 0x79, 0x70,
-	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x50, 0x6c,
-	0x61, 0x63, 0x65, 0x73, 0x54, 0x79, 0x70, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x68, 0x69, 0x6c,
-	0x64, 0x5f, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b,
-	0x63, 0x68, 0x69, 0x6c, 0x64, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x70,
-	0x61, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03,
-	0x28, 0x09, 0x52, 0x0c, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x73,
-	0x12, 0x25, 0x0a, 0x0e, 0x73, 0x69, 0x6d, 0x69, 0x6c, 0x61, 0x72, 0x5f, 0x70, 0x6c, 0x61, 0x63,
-	0x65, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x73, 0x69, 0x6d, 0x69, 0x6c, 0x61,
-	0x72, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x6e, 0x65, 0x61, 0x72, 0x62,
-	0x79, 0x5f, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c,
-	0x6e, 0x65, 0x61, 0x72, 0x62, 0x79, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x73, 0x1a, 0x5c, 0x0a, 0x12,
-	0x53, 0x74, 0x61, 0x74, 0x56, 0x61, 0x72, 0x53, 0x65, 0x72, 0x69, 0x65, 0x73, 0x45, 0x6e, 0x74,
-	0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x03, 0x6b, 0x65, 0x79, 0x12, 0x30, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e,
-	0x73, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x56, 0x61, 0x72, 0x53, 0x65, 0x72, 0x69, 0x65, 0x73, 0x52,
-	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x56, 0x0a, 0x13, 0x41, 0x6c,
-	0x6c, 0x43, 0x68, 0x69, 0x6c, 0x64, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72,
-	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
-	0x6b, 0x65, 0x79, 0x12, 0x29, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x13, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73,
-	0x2e, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x73, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02,
-	0x38, 0x01, 0x22, 0x46, 0x0a, 0x0d, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x61, 0x78,
-	0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a,
-	0x6d, 0x61, 0x78, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x22, 0x4c, 0x0a, 0x0e, 0x53, 0x65,
-	0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3a, 0x0a, 0x07,
-	0x73, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e,
-	0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x53, 0x65, 0x61, 0x72,
-	0x63, 0x68, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52,
-	0x07, 0x73, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x13, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x56,
-	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x7f, 0x0a,
-	0x12, 0x47, 0x65, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x05, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x62, 0x69, 0x67,
-	0x5f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x62, 0x69,
-	0x67, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x1b, 0x0a, 0x09, 0x62, 0x69, 0x67, 0x5f, 0x74, 0x61,
-	0x62, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x62, 0x69, 0x67, 0x54, 0x61,
-	0x62, 0x6c, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x67, 0x69, 0x74, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x67, 0x69, 0x74, 0x48, 0x61, 0x73, 0x68, 0x22, 0x6b,
-	0x0a, 0x13, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x53, 0x65,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x79, 0x70, 0x65, 0x5f, 0x6e, 0x61,
-	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x79, 0x70, 0x65, 0x4e, 0x61,
-	0x6d, 0x65, 0x12, 0x37, 0x0a, 0x06, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x02, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73,
-	0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x65, 0x73,
-	0x75, 0x6c, 0x74, 0x52, 0x06, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x22, 0x3c, 0x0a, 0x12, 0x53,
-	0x65, 0x61, 0x72, 0x63, 0x68, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x65, 0x73, 0x75, 0x6c,
+	0x72, 0x6f, 0x74, 0x6f, 0x22, 0xc3, 0x01, 0x0a, 0x0c, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x70, 0x61, 0x72, 0x71, 0x6c, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x70, 0x61, 0x72, 0x71, 0x6c, 0x12, 0x1b, 0x0a,
+	0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61,
+	0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x6f, 0x72,
+	0x6d, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61,
+	0x74, 0x12, 0x17, 0x0a, 0x07, 0x64, 0x72, 0x79, 0x5f, 0x72, 0x75, 0x6e, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x06, 0x64, 0x72, 0x79, 0x52, 0x75, 0x6e, 0x12, 0x2e, 0x0a, 0x13, 0x6d, 0x61,
+	0x78, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x65,
+	0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x11, 0x6d, 0x61, 0x78, 0x42, 0x79, 0x74, 0x65,
+	0x73, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x65, 0x64, 0x22, 0xcd, 0x02, 0x0a, 0x11, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x43, 0x65, 0x6c, 0x6c,
+	0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x6e,
+	0x61, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x70,
+	0x72, 0x6f, 0x76, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x09, 0x69,
+	0x6e, 0x74, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x48, 0x00,
+	0x52, 0x08, 0x69, 0x6e, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x23, 0x0a, 0x0c, 0x64, 0x6f,
+	0x75, 0x62, 0x6c, 0x65, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01,
+	0x48, 0x00, 0x52, 0x0b, 0x64, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12,
+	0x1f, 0x0a, 0x0a, 0x62, 0x6f, 0x6f, 0x6c, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x08, 0x48, 0x00, 0x52, 0x09, 0x62, 0x6f, 0x6f, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x12, 0x1f, 0x0a, 0x0a, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x09, 0x64, 0x61, 0x74, 0x65, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x12, 0x23, 0x0a, 0x0c, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0b, 0x73, 0x74, 0x72, 0x69, 0x6e,
+	0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x43, 0x0a, 0x0a, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x64, 0x61, 0x74,
+	0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x43, 0x65, 0x6c, 0x6c, 0x4c, 0x69, 0x73, 0x74, 0x48, 0x00,
+	0x52, 0x09, 0x6c, 0x69, 0x73, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x0d, 0x0a, 0x0b, 0x74,
+	0x79, 0x70, 0x65, 0x64, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x4d, 0x0a, 0x15, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x43, 0x65, 0x6c, 0x6c, 0x4c,
+	0x69, 0x73, 0x74, 0x12, 0x34, 0x0a, 0x05, 0x63, 0x65, 0x6c, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73,
+	0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x43, 0x65,
+	0x6c, 0x6c, 0x52, 0x05, 0x63, 0x65, 0x6c, 0x6c, 0x73, 0x22, 0x48, 0x0a, 0x10, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x6f, 0x77, 0x12, 0x34, 0x0a,
+	0x05, 0x63, 0x65, 0x6c, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x64,
+	0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x43, 0x65, 0x6c, 0x6c, 0x52, 0x05, 0x63, 0x65,
+	0x6c, 0x6c, 0x73, 0x22, 0xcf, 0x02, 0x0a, 0x0d, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x31, 0x0a,
+	0x04, 0x72, 0x6f, 0x77, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x64, 0x61,
+	0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x6f, 0x77, 0x52, 0x04, 0x72, 0x6f, 0x77, 0x73,
+	0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50,
+	0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x2d, 0x0a, 0x07, 0x74, 0x72, 0x69, 0x70,
+	0x6c, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x64, 0x61, 0x74, 0x61,
+	0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x54, 0x72, 0x69, 0x70, 0x6c, 0x65, 0x52, 0x07,
+	0x74, 0x72, 0x69, 0x70, 0x6c, 0x65, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f,
+	0x61, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61,
+	0x64, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x79, 0x70,
+	0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74,
+	0x54, 0x79, 0x70, 0x65, 0x12, 0x32, 0x0a, 0x15, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x62, 0x79,
+	0x74, 0x65, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x65, 0x64, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x13, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x42, 0x79, 0x74, 0x65, 0x73, 0x50,
+	0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x65, 0x64, 0x12, 0x2b, 0x0a, 0x11, 0x72, 0x65, 0x66, 0x65,
+	0x72, 0x65, 0x6e, 0x63, 0x65, 0x64, 0x5f, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x18, 0x08, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x10, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x64, 0x54,
+	0x61, 0x62, 0x6c, 0x65, 0x73, 0x22, 0x60, 0x0a, 0x13, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x74,
+	0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06,
+	0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x68, 0x65,
+	0x61, 0x64, 0x65, 0x72, 0x12, 0x31, 0x0a, 0x04, 0x72, 0x6f, 0x77, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73,
+	0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x6f,
+	0x77, 0x52, 0x04, 0x72, 0x6f, 0x77, 0x73, 0x22, 0x85, 0x01, 0x0a, 0x10, 0x54, 0x72, 0x61, 0x6e,
+	0x73, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x25, 0x0a, 0x0e,
+	0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x5f, 0x6d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x4d, 0x61, 0x70, 0x70,
+	0x69, 0x6e, 0x67, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x70, 0x61, 0x72, 0x71, 0x6c, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x70, 0x61, 0x72, 0x71, 0x6c, 0x12, 0x18, 0x0a, 0x07, 0x65,
+	0x78, 0x70, 0x6c, 0x61, 0x69, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65, 0x78,
+	0x70, 0x6c, 0x61, 0x69, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x69, 0x61, 0x6c, 0x65, 0x63, 0x74,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x64, 0x69, 0x61, 0x6c, 0x65, 0x63, 0x74, 0x22,
+	0x5b, 0x0a, 0x11, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x71, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x73, 0x71, 0x6c, 0x12, 0x20, 0x0a, 0x0b, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x6c,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x74, 0x72, 0x61,
+	0x6e, 0x73, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x6c, 0x61, 0x6e,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x6c, 0x61, 0x6e, 0x22, 0x30, 0x0a, 0x18,
+	0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x4c, 0x61, 0x62, 0x65, 0x6c,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x63, 0x69, 0x64,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x64, 0x63, 0x69, 0x64, 0x73, 0x22, 0x35,
+	0x0a, 0x19, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x4c, 0x61, 0x62,
+	0x65, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x70,
+	0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x61,
+	0x79, 0x6c, 0x6f, 0x61, 0x64, 0x22, 0x9f, 0x01, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f,
+	0x70, 0x65, 0x72, 0x74, 0x79, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x63, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x05, 0x64, 0x63, 0x69, 0x64, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x70, 0x65,
+	0x72, 0x74, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x70, 0x65,
+	0x72, 0x74, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x64, 0x69, 0x72,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x64, 0x69,
+	0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x35, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x50, 0x72,
+	0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x22, 0x3f,
+	0x0a, 0x11, 0x47, 0x65, 0x74, 0x54, 0x72, 0x69, 0x70, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x63, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x05, 0x64, 0x63, 0x69, 0x64, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d,
+	0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22,
+	0x2e, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x54, 0x72, 0x69, 0x70, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x22,
+	0x41, 0x0a, 0x0d, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x12, 0x14, 0x0a, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x22, 0x62, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x4f, 0x62,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x6c, 0x61, 0x63,
+	0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x6c,
+	0x61, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x73, 0x74, 0x61, 0x74, 0x5f,
+	0x76, 0x61, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x74, 0x61, 0x74, 0x56,
+	0x61, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x64, 0x61, 0x74, 0x65, 0x22, 0x49, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61,
+	0x63, 0x65, 0x73, 0x49, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05,
+	0x64, 0x63, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x64, 0x63, 0x69,
+	0x64, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x54, 0x79, 0x70,
+	0x65, 0x22, 0x2f, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x73, 0x49, 0x6e,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c,
+	0x6f, 0x61, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f,
+	0x61, 0x64, 0x22, 0x9d, 0x01, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x65,
+	0x64, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
 	0x74, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x63, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x04, 0x64, 0x63, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x29, 0x0a, 0x09, 0x53, 0x74, 0x61,
-	0x74, 0x73, 0x56, 0x61, 0x72, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x74, 0x61, 0x74, 0x73, 0x56,
-	0x61, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x73, 0x74, 0x61, 0x74, 0x73,
-	0x56, 0x61, 0x72, 0x73, 0x22, 0x2f, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x63, 0x65,
-	0x53, 0x74, 0x61, 0x74, 0x73, 0x56, 0x61, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x14, 0x0a, 0x05, 0x64, 0x63, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05,
-	0x64, 0x63, 0x69, 0x64, 0x73, 0x22, 0xb8, 0x01, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61,
-	0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x56, 0x61, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x49, 0x0a, 0x06, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x31, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73,
-	0x2e, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x56, 0x61,
-	0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x73,
-	0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x73, 0x1a, 0x51, 0x0a,
-	0x0b, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03,
-	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2c,
-	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e,
-	0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x53, 0x74, 0x61, 0x74,
-	0x73, 0x56, 0x61, 0x72, 0x73, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01,
-	0x22, 0x27, 0x0a, 0x08, 0x53, 0x74, 0x61, 0x74, 0x56, 0x61, 0x72, 0x73, 0x12, 0x1b, 0x0a, 0x09,
-	0x73, 0x74, 0x61, 0x74, 0x5f, 0x76, 0x61, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52,
-	0x08, 0x73, 0x74, 0x61, 0x74, 0x56, 0x61, 0x72, 0x73, 0x22, 0x2f, 0x0a, 0x17, 0x47, 0x65, 0x74,
-	0x50, 0x6c, 0x61, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x56, 0x61, 0x72, 0x73, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x63, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20,
-	0x03, 0x28, 0x09, 0x52, 0x05, 0x64, 0x63, 0x69, 0x64, 0x73, 0x22, 0xb7, 0x01, 0x0a, 0x18, 0x47,
-	0x65, 0x74, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x56, 0x61, 0x72, 0x73, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x49, 0x0a, 0x06, 0x70, 0x6c, 0x61, 0x63, 0x65,
-	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x31, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f,
-	0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x53, 0x74,
-	0x61, 0x74, 0x56, 0x61, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x50,
-	0x6c, 0x61, 0x63, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x70, 0x6c, 0x61, 0x63,
-	0x65, 0x73, 0x1a, 0x50, 0x0a, 0x0b, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72,
+	0x04, 0x64, 0x63, 0x69, 0x64, 0x12, 0x24, 0x0a, 0x0e, 0x73, 0x74, 0x61, 0x74, 0x5f, 0x76, 0x61,
+	0x72, 0x5f, 0x64, 0x63, 0x69, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x73,
+	0x74, 0x61, 0x74, 0x56, 0x61, 0x72, 0x44, 0x63, 0x69, 0x64, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x77,
+	0x69, 0x74, 0x68, 0x69, 0x6e, 0x5f, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x77, 0x69, 0x74, 0x68, 0x69, 0x6e, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x12, 0x22,
+	0x0a, 0x0d, 0x69, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x63, 0x61, 0x70, 0x69, 0x74, 0x61, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x69, 0x73, 0x50, 0x65, 0x72, 0x43, 0x61, 0x70, 0x69,
+	0x74, 0x61, 0x22, 0xa9, 0x01, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x52, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x24, 0x0a, 0x0e, 0x73, 0x74, 0x61, 0x74, 0x5f, 0x76, 0x61, 0x72, 0x5f, 0x64,
+	0x63, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x74, 0x61, 0x74,
+	0x56, 0x61, 0x72, 0x44, 0x63, 0x69, 0x64, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x6c, 0x61, 0x63,
+	0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x6c,
+	0x61, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x77, 0x69, 0x74, 0x68, 0x69,
+	0x6e, 0x5f, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x77,
+	0x69, 0x74, 0x68, 0x69, 0x6e, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x12, 0x22, 0x0a, 0x0d, 0x69, 0x73,
+	0x5f, 0x70, 0x65, 0x72, 0x5f, 0x63, 0x61, 0x70, 0x69, 0x74, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x0b, 0x69, 0x73, 0x50, 0x65, 0x72, 0x43, 0x61, 0x70, 0x69, 0x74, 0x61, 0x22, 0xcc,
+	0x01, 0x0a, 0x1c, 0x47, 0x65, 0x74, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52,
+	0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x50, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x36, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x47,
+	0x65, 0x74, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x61, 0x6e, 0x6b, 0x69,
+	0x6e, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x50, 0x61, 0x79, 0x6c,
+	0x6f, 0x61, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61,
+	0x64, 0x1a, 0x5a, 0x0a, 0x0c, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x45, 0x6e, 0x74, 0x72,
 	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
-	0x6b, 0x65, 0x79, 0x12, 0x2b, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x15, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73,
-	0x2e, 0x53, 0x74, 0x61, 0x74, 0x56, 0x61, 0x72, 0x73, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
-	0x3a, 0x02, 0x38, 0x01, 0x22, 0x34, 0x0a, 0x1c, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x63, 0x65,
-	0x53, 0x74, 0x61, 0x74, 0x56, 0x61, 0x72, 0x73, 0x55, 0x6e, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x63, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20,
-	0x03, 0x28, 0x09, 0x52, 0x05, 0x64, 0x63, 0x69, 0x64, 0x73, 0x22, 0x53, 0x0a, 0x1d, 0x47, 0x65,
-	0x74, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x56, 0x61, 0x72, 0x73, 0x55, 0x6e,
-	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x32, 0x0a, 0x09, 0x73,
-	0x74, 0x61, 0x74, 0x5f, 0x76, 0x61, 0x72, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15,
-	0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x53, 0x74, 0x61,
-	0x74, 0x56, 0x61, 0x72, 0x73, 0x52, 0x08, 0x73, 0x74, 0x61, 0x74, 0x56, 0x61, 0x72, 0x73, 0x22,
-	0x3e, 0x0a, 0x1f, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x56,
-	0x61, 0x72, 0x73, 0x55, 0x6e, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x56, 0x31, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x74, 0x61, 0x74, 0x5f, 0x76, 0x61, 0x72, 0x73, 0x18,
+	0x6b, 0x65, 0x79, 0x12, 0x34, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73,
+	0x2e, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x65, 0x64, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x73, 0x49, 0x6e,
+	0x66, 0x6f, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x37, 0x0a,
+	0x1b, 0x47, 0x65, 0x74, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x65, 0x64, 0x4c, 0x6f, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07,
+	0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70,
+	0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x22, 0x41, 0x0a, 0x05, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x12,
+	0x12, 0x0a, 0x04, 0x64, 0x63, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x64,
+	0x63, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x70, 0x6f, 0x70, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x70, 0x6f, 0x70, 0x22, 0x34, 0x0a, 0x06, 0x50, 0x6c, 0x61,
+	0x63, 0x65, 0x73, 0x12, 0x2a, 0x0a, 0x06, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e,
+	0x73, 0x2e, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x52, 0x06, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x73, 0x22,
+	0x6f, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x4c, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x50, 0x61, 0x67,
+	0x65, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05,
+	0x70, 0x6c, 0x61, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x70, 0x6c, 0x61,
+	0x63, 0x65, 0x12, 0x22, 0x0a, 0x0d, 0x6e, 0x65, 0x77, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x5f, 0x76,
+	0x61, 0x72, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x6e, 0x65, 0x77, 0x53, 0x74,
+	0x61, 0x74, 0x56, 0x61, 0x72, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x65, 0x65, 0x64, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x73, 0x65, 0x65, 0x64, 0x4a, 0x04, 0x08, 0x02, 0x10, 0x03,
+	0x22, 0xdd, 0x04, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x4c, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x50,
+	0x61, 0x67, 0x65, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x62, 0x0a, 0x0f, 0x73, 0x74, 0x61, 0x74, 0x5f, 0x76, 0x61, 0x72, 0x5f, 0x73, 0x65, 0x72, 0x69,
+	0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3a, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63,
+	0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x61, 0x6e, 0x64, 0x69, 0x6e,
+	0x67, 0x50, 0x61, 0x67, 0x65, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x56, 0x61, 0x72, 0x53, 0x65, 0x72, 0x69, 0x65, 0x73, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x52, 0x0d, 0x73, 0x74, 0x61, 0x74, 0x56, 0x61, 0x72, 0x53, 0x65, 0x72,
+	0x69, 0x65, 0x73, 0x12, 0x65, 0x0a, 0x10, 0x61, 0x6c, 0x6c, 0x5f, 0x63, 0x68, 0x69, 0x6c, 0x64,
+	0x5f, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3b, 0x2e,
+	0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x4c,
+	0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x50, 0x61, 0x67, 0x65, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x41, 0x6c, 0x6c, 0x43, 0x68, 0x69, 0x6c, 0x64, 0x50,
+	0x6c, 0x61, 0x63, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0e, 0x61, 0x6c, 0x6c, 0x43,
+	0x68, 0x69, 0x6c, 0x64, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x73, 0x12, 0x2a, 0x0a, 0x11, 0x63, 0x68,
+	0x69, 0x6c, 0x64, 0x5f, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x73, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x50, 0x6c, 0x61, 0x63,
+	0x65, 0x73, 0x54, 0x79, 0x70, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x5f,
+	0x70, 0x6c, 0x61, 0x63, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x68,
+	0x69, 0x6c, 0x64, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x70, 0x61, 0x72,
+	0x65, 0x6e, 0x74, 0x5f, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x0c, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x73, 0x12, 0x25,
+	0x0a, 0x0e, 0x73, 0x69, 0x6d, 0x69, 0x6c, 0x61, 0x72, 0x5f, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x73,
+	0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x73, 0x69, 0x6d, 0x69, 0x6c, 0x61, 0x72, 0x50,
+	0x6c, 0x61, 0x63, 0x65, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x6e, 0x65, 0x61, 0x72, 0x62, 0x79, 0x5f,
+	0x70, 0x6c, 0x61, 0x63, 0x65, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x6e, 0x65,
+	0x61, 0x72, 0x62, 0x79, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x73, 0x1a, 0x5c, 0x0a, 0x12, 0x53, 0x74,
+	0x61, 0x74, 0x56, 0x61, 0x72, 0x53, 0x65, 0x72, 0x69, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b,
+	0x65, 0x79, 0x12, 0x30, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e,
+	0x53, 0x74, 0x61, 0x74, 0x56, 0x61, 0x72, 0x53, 0x65, 0x72, 0x69, 0x65, 0x73, 0x52, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x56, 0x0a, 0x13, 0x41, 0x6c, 0x6c, 0x43,
+	0x68, 0x69, 0x6c, 0x64, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
+	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65,
+	0x79, 0x12, 0x29, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x13, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x50,
+	0x6c, 0x61, 0x63, 0x65, 0x73, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01,
+	0x22, 0x46, 0x0a, 0x0d, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x14, 0x0a, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x61, 0x78, 0x5f, 0x72,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x6d, 0x61,
+	0x78, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x22, 0x4c, 0x0a, 0x0e, 0x53, 0x65, 0x61, 0x72,
+	0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3a, 0x0a, 0x07, 0x73, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x64, 0x61,
+	0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68,
+	0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x73,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x13, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x56, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xd6, 0x01, 0x0a, 0x12,
+	0x47, 0x65, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x62, 0x69, 0x67, 0x5f,
+	0x71, 0x75, 0x65, 0x72, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x62, 0x69, 0x67,
+	0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x1b, 0x0a, 0x09, 0x62, 0x69, 0x67, 0x5f, 0x74, 0x61, 0x62,
+	0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x62, 0x69, 0x67, 0x54, 0x61, 0x62,
+	0x6c, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x67, 0x69, 0x74, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x67, 0x69, 0x74, 0x48, 0x61, 0x73, 0x68, 0x12, 0x21, 0x0a,
+	0x0c, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x5f, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x54, 0x61, 0x62, 0x6c, 0x65,
+	0x12, 0x32, 0x0a, 0x15, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x5f, 0x62, 0x72, 0x61,
+	0x6e, 0x63, 0x68, 0x5f, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x13, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x42, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x54,
+	0x61, 0x62, 0x6c, 0x65, 0x22, 0x6b, 0x0a, 0x13, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x74,
+	0x79, 0x70, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x74, 0x79, 0x70, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x37, 0x0a, 0x06, 0x65, 0x6e, 0x74, 0x69,
+	0x74, 0x79, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63,
+	0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x45, 0x6e, 0x74,
+	0x69, 0x74, 0x79, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x06, 0x65, 0x6e, 0x74, 0x69, 0x74,
+	0x79, 0x22, 0x3c, 0x0a, 0x12, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x45, 0x6e, 0x74, 0x69, 0x74,
+	0x79, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x63, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x64, 0x63, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22,
+	0x29, 0x0a, 0x09, 0x53, 0x74, 0x61, 0x74, 0x73, 0x56, 0x61, 0x72, 0x73, 0x12, 0x1c, 0x0a, 0x09,
+	0x73, 0x74, 0x61, 0x74, 0x73, 0x56, 0x61, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x09, 0x73, 0x74, 0x61, 0x74, 0x73, 0x56, 0x61, 0x72, 0x73, 0x22, 0x2f, 0x0a, 0x17, 0x47, 0x65,
+	0x74, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x56, 0x61, 0x72, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x63, 0x69, 0x64, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x64, 0x63, 0x69, 0x64, 0x73, 0x22, 0xb8, 0x01, 0x0a, 0x18,
+	0x47, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x56, 0x61, 0x72,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x49, 0x0a, 0x06, 0x70, 0x6c, 0x61, 0x63,
+	0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x31, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63,
+	0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x53,
+	0x74, 0x61, 0x74, 0x73, 0x56, 0x61, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e,
+	0x50, 0x6c, 0x61, 0x63, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x70, 0x6c, 0x61,
+	0x63, 0x65, 0x73, 0x1a, 0x51, 0x0a, 0x0b, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x73, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x6b, 0x65, 0x79, 0x12, 0x2c, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e,
+	0x73, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x56, 0x61, 0x72, 0x73, 0x52, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x27, 0x0a, 0x08, 0x53, 0x74, 0x61, 0x74, 0x56, 0x61,
+	0x72, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x74, 0x61, 0x74, 0x5f, 0x76, 0x61, 0x72, 0x73, 0x18,
 	0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x73, 0x74, 0x61, 0x74, 0x56, 0x61, 0x72, 0x73, 0x22,
-	0xc7, 0x01, 0x0a, 0x0d, 0x53, 0x74, 0x61, 0x74, 0x56, 0x61, 0x72, 0x47, 0x72, 0x6f, 0x75, 0x70,
-	0x73, 0x12, 0x55, 0x0a, 0x0f, 0x73, 0x74, 0x61, 0x74, 0x5f, 0x76, 0x61, 0x72, 0x5f, 0x67, 0x72,
-	0x6f, 0x75, 0x70, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x64, 0x61, 0x74,
-	0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x56, 0x61, 0x72,
-	0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x56, 0x61, 0x72, 0x47, 0x72,
-	0x6f, 0x75, 0x70, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0d, 0x73, 0x74, 0x61, 0x74, 0x56,
-	0x61, 0x72, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x1a, 0x5f, 0x0a, 0x12, 0x53, 0x74, 0x61, 0x74,
-	0x56, 0x61, 0x72, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10,
-	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
-	0x12, 0x33, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x1d, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x53, 0x74,
-	0x61, 0x74, 0x56, 0x61, 0x72, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x05,
-	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x9c, 0x04, 0x0a, 0x10, 0x53, 0x74,
-	0x61, 0x74, 0x56, 0x61, 0x72, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x23,
-	0x0a, 0x0d, 0x61, 0x62, 0x73, 0x6f, 0x6c, 0x75, 0x74, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x61, 0x62, 0x73, 0x6f, 0x6c, 0x75, 0x74, 0x65, 0x4e,
-	0x61, 0x6d, 0x65, 0x12, 0x4d, 0x0a, 0x0f, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x5f, 0x73, 0x74, 0x61,
-	0x74, 0x5f, 0x76, 0x61, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x64,
-	0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x56,
-	0x61, 0x72, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4e, 0x6f, 0x64, 0x65, 0x2e, 0x43, 0x68, 0x69, 0x6c,
-	0x64, 0x53, 0x56, 0x52, 0x0d, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x53, 0x74, 0x61, 0x74, 0x56, 0x61,
-	0x72, 0x73, 0x12, 0x59, 0x0a, 0x15, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x5f, 0x73, 0x74, 0x61, 0x74,
-	0x5f, 0x76, 0x61, 0x72, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x26, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e,
-	0x53, 0x74, 0x61, 0x74, 0x56, 0x61, 0x72, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4e, 0x6f, 0x64, 0x65,
-	0x2e, 0x43, 0x68, 0x69, 0x6c, 0x64, 0x53, 0x56, 0x47, 0x52, 0x12, 0x63, 0x68, 0x69, 0x6c, 0x64,
-	0x53, 0x74, 0x61, 0x74, 0x56, 0x61, 0x72, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x12, 0x33, 0x0a,
-	0x16, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x5f, 0x76, 0x61, 0x72,
-	0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x13, 0x70,
-	0x61, 0x72, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x56, 0x61, 0x72, 0x47, 0x72, 0x6f, 0x75,
-	0x70, 0x73, 0x12, 0x37, 0x0a, 0x18, 0x6e, 0x75, 0x6d, 0x5f, 0x64, 0x65, 0x73, 0x63, 0x65, 0x6e,
-	0x64, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x5f, 0x76, 0x61, 0x72, 0x73, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x05, 0x52, 0x15, 0x6e, 0x75, 0x6d, 0x44, 0x65, 0x73, 0x63, 0x65, 0x6e, 0x64,
-	0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x56, 0x61, 0x72, 0x73, 0x1a, 0x6c, 0x0a, 0x08, 0x43,
-	0x68, 0x69, 0x6c, 0x64, 0x53, 0x56, 0x47, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x2d, 0x0a, 0x12, 0x73, 0x70, 0x65, 0x63, 0x69,
-	0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x5f, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x11, 0x73, 0x70, 0x65, 0x63, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64,
-	0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x21, 0x0a, 0x0c, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61,
-	0x79, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x69,
-	0x73, 0x70, 0x6c, 0x61, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x1a, 0x5d, 0x0a, 0x07, 0x43, 0x68, 0x69,
-	0x6c, 0x64, 0x53, 0x56, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x02, 0x69, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x6e,
-	0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x65, 0x61, 0x72, 0x63,
-	0x68, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79,
-	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x69, 0x73,
-	0x70, 0x6c, 0x61, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x36, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x53,
-	0x74, 0x61, 0x74, 0x56, 0x61, 0x72, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03,
-	0x28, 0x09, 0x52, 0x06, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x73, 0x4a, 0x04, 0x08, 0x01, 0x10, 0x02,
-	0x22, 0x86, 0x01, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x56, 0x61, 0x72, 0x47,
-	0x72, 0x6f, 0x75, 0x70, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x24, 0x0a, 0x0e, 0x73, 0x74, 0x61, 0x74, 0x5f, 0x76, 0x61, 0x72, 0x5f, 0x67, 0x72, 0x6f, 0x75,
-	0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x74, 0x61, 0x74, 0x56, 0x61, 0x72,
-	0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x73, 0x18,
-	0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x73, 0x12, 0x2a, 0x0a,
-	0x11, 0x72, 0x65, 0x61, 0x64, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x74, 0x72, 0x69, 0x70, 0x6c,
-	0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x72, 0x65, 0x61, 0x64, 0x46, 0x72,
-	0x6f, 0x6d, 0x54, 0x72, 0x69, 0x70, 0x6c, 0x65, 0x73, 0x22, 0xa1, 0x02, 0x0a, 0x08, 0x53, 0x56,
-	0x4f, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79,
-	0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x12,
-	0x0a, 0x04, 0x64, 0x63, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x64, 0x63,
-	0x69, 0x64, 0x12, 0x3f, 0x0a, 0x0c, 0x6f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63,
-	0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x53, 0x56, 0x4f, 0x4f, 0x62, 0x73, 0x65, 0x72, 0x76,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x6f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x6e, 0x61, 0x6e, 0x63,
-	0x65, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x70, 0x72, 0x6f, 0x76,
-	0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x12, 0x2e, 0x0a, 0x04, 0x74, 0x65, 0x6d, 0x70,
-	0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d,
-	0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x53, 0x56, 0x4f, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x2e, 0x54, 0x65,
-	0x6d, 0x70, 0x52, 0x04, 0x74, 0x65, 0x6d, 0x70, 0x1a, 0x43, 0x0a, 0x04, 0x54, 0x65, 0x6d, 0x70,
-	0x12, 0x21, 0x0a, 0x0c, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x5f, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x73,
-	0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x50, 0x6c, 0x61,
-	0x63, 0x65, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x69, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28,
-	0x09, 0x52, 0x04, 0x6d, 0x69, 0x64, 0x73, 0x4a, 0x04, 0x08, 0x01, 0x10, 0x02, 0x22, 0x8c, 0x04,
-	0x0a, 0x0e, 0x53, 0x56, 0x4f, 0x4f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x12, 0x12, 0x0a, 0x04, 0x64, 0x63, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
-	0x64, 0x63, 0x69, 0x64, 0x12, 0x29, 0x0a, 0x10, 0x6f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f,
-	0x6f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x65, 0x12,
-	0x2d, 0x0a, 0x12, 0x6f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x70,
-	0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x6f, 0x62, 0x73,
-	0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x2d,
-	0x0a, 0x12, 0x6d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x6d, 0x65,
-	0x74, 0x68, 0x6f, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x6d, 0x65, 0x61, 0x73,
-	0x75, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x12, 0x0a,
-	0x04, 0x75, 0x6e, 0x69, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x6e, 0x69,
-	0x74, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x63, 0x61, 0x6c, 0x69, 0x6e, 0x67, 0x5f, 0x66, 0x61, 0x63,
-	0x74, 0x6f, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x73, 0x63, 0x61, 0x6c, 0x69,
-	0x6e, 0x67, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x23, 0x0a, 0x0d, 0x70, 0x72, 0x6f, 0x76,
-	0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0c, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x12, 0x1d, 0x0a,
-	0x09, 0x73, 0x74, 0x72, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09,
-	0x48, 0x00, 0x52, 0x08, 0x73, 0x74, 0x72, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x1d, 0x0a, 0x09,
-	0x64, 0x62, 0x6c, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x01, 0x48,
-	0x00, 0x52, 0x08, 0x64, 0x62, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x34, 0x0a, 0x04, 0x74,
-	0x65, 0x6d, 0x70, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x64, 0x61, 0x74, 0x61,
-	0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x53, 0x56, 0x4f, 0x4f, 0x62, 0x73, 0x65, 0x72,
-	0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x54, 0x65, 0x6d, 0x70, 0x52, 0x04, 0x74, 0x65, 0x6d,
-	0x70, 0x1a, 0x81, 0x01, 0x0a, 0x04, 0x54, 0x65, 0x6d, 0x70, 0x12, 0x2b, 0x0a, 0x11, 0x6f, 0x62,
-	0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x61, 0x62, 0x6f, 0x75, 0x74, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x6f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x41, 0x62, 0x6f, 0x75, 0x74, 0x12, 0x2b, 0x0a, 0x11, 0x76, 0x61, 0x72, 0x69, 0x61,
-	0x62, 0x6c, 0x65, 0x5f, 0x6d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x10, 0x76, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x4d, 0x65, 0x61, 0x73,
-	0x75, 0x72, 0x65, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x6e,
-	0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x6d, 0x70, 0x6f, 0x72,
-	0x74, 0x4e, 0x61, 0x6d, 0x65, 0x42, 0x05, 0x0a, 0x03, 0x76, 0x61, 0x6c, 0x22, 0x3e, 0x0a, 0x0d,
-	0x53, 0x56, 0x4f, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2d, 0x0a,
-	0x06, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e,
-	0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x53, 0x56, 0x4f, 0x50,
-	0x6c, 0x61, 0x63, 0x65, 0x52, 0x06, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x73, 0x22, 0x85, 0x01, 0x0a,
-	0x0a, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x12, 0x0a, 0x04, 0x6e,
-	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
-	0x14, 0x0a, 0x05, 0x74, 0x79, 0x70, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05,
-	0x74, 0x79, 0x70, 0x65, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x63, 0x69, 0x64, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x04, 0x64, 0x63, 0x69, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x70, 0x72, 0x6f,
-	0x76, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0c, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x12, 0x14,
-	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76,
-	0x61, 0x6c, 0x75, 0x65, 0x22, 0x6c, 0x0a, 0x14, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x49, 0x6e,
-	0x66, 0x6f, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x33, 0x0a, 0x08,
-	0x65, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17,
-	0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x45, 0x6e, 0x74,
-	0x69, 0x74, 0x79, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x08, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65,
-	0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x43, 0x6f, 0x75,
-	0x6e, 0x74, 0x22, 0x92, 0x01, 0x0a, 0x18, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x64,
-	0x49, 0x6e, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
-	0x1b, 0x0a, 0x09, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b,
-	0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0a, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x19, 0x0a,
-	0x08, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x07, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x68, 0x69, 0x6c,
-	0x64, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x68,
-	0x69, 0x6c, 0x64, 0x54, 0x79, 0x70, 0x65, 0x22, 0xb6, 0x02, 0x0a, 0x06, 0x54, 0x72, 0x69, 0x70,
-	0x6c, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x49,
-	0x64, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x72, 0x65, 0x64, 0x69, 0x63, 0x61, 0x74, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x65, 0x64, 0x69, 0x63, 0x61, 0x74, 0x65, 0x12,
-	0x1b, 0x0a, 0x09, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x08, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b,
-	0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0a, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x21, 0x0a,
-	0x0c, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x05, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0b, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65,
-	0x12, 0x23, 0x0a, 0x0d, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x69,
-	0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x6e, 0x61,
-	0x6e, 0x63, 0x65, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74,
-	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x75, 0x62,
-	0x6a, 0x65, 0x63, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x75, 0x62, 0x6a,
-	0x65, 0x63, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x09, 0x52,
-	0x0c, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x54, 0x79, 0x70, 0x65, 0x73, 0x12, 0x21, 0x0a,
-	0x0c, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x18, 0x0a, 0x20,
-	0x03, 0x28, 0x09, 0x52, 0x0b, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x54, 0x79, 0x70, 0x65, 0x73,
-	0x22, 0x59, 0x0a, 0x07, 0x54, 0x72, 0x69, 0x70, 0x6c, 0x65, 0x73, 0x12, 0x2d, 0x0a, 0x07, 0x74,
-	0x72, 0x69, 0x70, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x64,
-	0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x54, 0x72, 0x69, 0x70, 0x6c,
-	0x65, 0x52, 0x07, 0x74, 0x72, 0x69, 0x70, 0x6c, 0x65, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x6f,
-	0x74, 0x61, 0x6c, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x6e, 0x0a, 0x0e, 0x50,
-	0x72, 0x6f, 0x76, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x23, 0x0a,
-	0x0d, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65,
-	0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x06, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6d,
-	0x70, 0x6f, 0x72, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0a, 0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x4c, 0x0a, 0x0b, 0x50,
-	0x72, 0x6f, 0x76, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x3d, 0x0a, 0x0b, 0x70, 0x72,
-	0x6f, 0x76, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x1b, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x50, 0x72,
-	0x6f, 0x76, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0b, 0x70, 0x72,
-	0x6f, 0x76, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x22, 0x4c, 0x0a, 0x0e, 0x50, 0x72, 0x6f,
-	0x70, 0x65, 0x72, 0x74, 0x79, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x69,
-	0x6e, 0x5f, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08,
-	0x69, 0x6e, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x6f, 0x75, 0x74, 0x5f,
-	0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x6f, 0x75,
-	0x74, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x22, 0xa1, 0x04, 0x0a, 0x11, 0x52, 0x65, 0x6c, 0x61,
-	0x74, 0x65, 0x64, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x25, 0x0a,
-	0x0e, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x73, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x65, 0x64, 0x50, 0x6c,
-	0x61, 0x63, 0x65, 0x73, 0x12, 0x22, 0x0a, 0x0d, 0x72, 0x61, 0x6e, 0x6b, 0x5f, 0x66, 0x72, 0x6f,
-	0x6d, 0x5f, 0x74, 0x6f, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x72, 0x61, 0x6e,
-	0x6b, 0x46, 0x72, 0x6f, 0x6d, 0x54, 0x6f, 0x70, 0x12, 0x28, 0x0a, 0x10, 0x72, 0x61, 0x6e, 0x6b,
-	0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x62, 0x6f, 0x74, 0x74, 0x6f, 0x6d, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x0e, 0x72, 0x61, 0x6e, 0x6b, 0x46, 0x72, 0x6f, 0x6d, 0x42, 0x6f, 0x74, 0x74,
-	0x6f, 0x6d, 0x12, 0x41, 0x0a, 0x08, 0x72, 0x61, 0x6e, 0x6b, 0x5f, 0x61, 0x6c, 0x6c, 0x18, 0x07,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f,
-	0x6e, 0x73, 0x2e, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x65, 0x64, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x73,
-	0x49, 0x6e, 0x66, 0x6f, 0x2e, 0x52, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x72, 0x61,
-	0x6e, 0x6b, 0x41, 0x6c, 0x6c, 0x12, 0x4a, 0x0a, 0x0d, 0x72, 0x61, 0x6e, 0x6b, 0x5f, 0x74, 0x6f,
-	0x70, 0x5f, 0x31, 0x30, 0x30, 0x30, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x64,
-	0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x52, 0x65, 0x6c, 0x61, 0x74,
-	0x65, 0x64, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x2e, 0x52, 0x61, 0x6e,
-	0x6b, 0x69, 0x6e, 0x67, 0x52, 0x0b, 0x72, 0x61, 0x6e, 0x6b, 0x54, 0x6f, 0x70, 0x31, 0x30, 0x30,
-	0x30, 0x12, 0x50, 0x0a, 0x10, 0x72, 0x61, 0x6e, 0x6b, 0x5f, 0x62, 0x6f, 0x74, 0x74, 0x6f, 0x6d,
-	0x5f, 0x31, 0x30, 0x30, 0x30, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x64, 0x61,
-	0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x65,
-	0x64, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x2e, 0x52, 0x61, 0x6e, 0x6b,
-	0x69, 0x6e, 0x67, 0x52, 0x0e, 0x72, 0x61, 0x6e, 0x6b, 0x42, 0x6f, 0x74, 0x74, 0x6f, 0x6d, 0x31,
-	0x30, 0x30, 0x30, 0x1a, 0xa3, 0x01, 0x0a, 0x07, 0x52, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x12,
-	0x43, 0x0a, 0x04, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2f, 0x2e,
-	0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x52, 0x65, 0x6c, 0x61,
-	0x74, 0x65, 0x64, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x2e, 0x52, 0x61,
-	0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x52, 0x61, 0x6e, 0x6b, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x04,
-	0x69, 0x6e, 0x66, 0x6f, 0x1a, 0x53, 0x0a, 0x08, 0x52, 0x61, 0x6e, 0x6b, 0x49, 0x6e, 0x66, 0x6f,
-	0x12, 0x12, 0x0a, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04,
-	0x72, 0x61, 0x6e, 0x6b, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x01, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x6c,
-	0x61, 0x63, 0x65, 0x5f, 0x64, 0x63, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
-	0x70, 0x6c, 0x61, 0x63, 0x65, 0x44, 0x63, 0x69, 0x64, 0x4a, 0x04, 0x08, 0x04, 0x10, 0x05, 0x4a,
-	0x04, 0x08, 0x05, 0x10, 0x06, 0x4a, 0x04, 0x08, 0x06, 0x10, 0x07, 0x22, 0x27, 0x0a, 0x15, 0x47,
-	0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x56, 0x61, 0x72, 0x50, 0x61, 0x74, 0x68, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x02, 0x69, 0x64, 0x22, 0x2c, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x56,
-	0x61, 0x72, 0x50, 0x61, 0x74, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12,
-	0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61,
-	0x74, 0x68, 0x22, 0x44, 0x0a, 0x14, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x53, 0x74, 0x61, 0x74,
-	0x56, 0x61, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x71, 0x75,
-	0x65, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79,
-	0x12, 0x16, 0x0a, 0x06, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09,
-	0x52, 0x06, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x73, 0x22, 0x8e, 0x01, 0x0a, 0x15, 0x53, 0x65, 0x61,
-	0x72, 0x63, 0x68, 0x53, 0x74, 0x61, 0x74, 0x56, 0x61, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x34, 0x0a, 0x09, 0x73, 0x74, 0x61, 0x74, 0x5f, 0x76, 0x61, 0x72, 0x73, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d,
-	0x6f, 0x6e, 0x73, 0x2e, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x08,
-	0x73, 0x74, 0x61, 0x74, 0x56, 0x61, 0x72, 0x73, 0x12, 0x3f, 0x0a, 0x0f, 0x73, 0x74, 0x61, 0x74,
-	0x5f, 0x76, 0x61, 0x72, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x17, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e,
-	0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0d, 0x73, 0x74, 0x61, 0x74,
-	0x56, 0x61, 0x72, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x32, 0xf4, 0x1e, 0x0a, 0x05, 0x4d, 0x69,
-	0x78, 0x65, 0x72, 0x12, 0x5b, 0x0a, 0x05, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x19, 0x2e, 0x64,
-	0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f,
-	0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x1b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x15, 0x12, 0x06, 0x2f, 0x71, 0x75,
-	0x65, 0x72, 0x79, 0x5a, 0x0b, 0x22, 0x06, 0x2f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x3a, 0x01, 0x2a,
-	0x12, 0x9d, 0x01, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79,
-	0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x12, 0x25, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d,
-	0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79,
-	0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e,
-	0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x50,
-	0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x39, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x33, 0x12, 0x15, 0x2f,
-	0x6e, 0x6f, 0x64, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x2d, 0x6c, 0x61,
-	0x62, 0x65, 0x6c, 0x73, 0x5a, 0x1a, 0x22, 0x15, 0x2f, 0x6e, 0x6f, 0x64, 0x65, 0x2f, 0x70, 0x72,
-	0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x2d, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x3a, 0x01, 0x2a,
-	0x12, 0x9d, 0x01, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79,
-	0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x12, 0x25, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d,
-	0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79,
-	0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e,
+	0x2f, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x56,
+	0x61, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x63,
+	0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x64, 0x63, 0x69, 0x64, 0x73,
+	0x22, 0xb7, 0x01, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x53, 0x74, 0x61,
+	0x74, 0x56, 0x61, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x49, 0x0a,
+	0x06, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x31, 0x2e,
 	0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x50,
-	0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x39, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x33, 0x12, 0x15, 0x2f,
-	0x6e, 0x6f, 0x64, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x2d, 0x76, 0x61,
-	0x6c, 0x75, 0x65, 0x73, 0x5a, 0x1a, 0x22, 0x15, 0x2f, 0x6e, 0x6f, 0x64, 0x65, 0x2f, 0x70, 0x72,
-	0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x2d, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x3a, 0x01, 0x2a,
-	0x12, 0x78, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x54, 0x72, 0x69, 0x70, 0x6c, 0x65, 0x73, 0x12, 0x1e,
-	0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x47, 0x65, 0x74,
-	0x54, 0x72, 0x69, 0x70, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f,
+	0x6c, 0x61, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x56, 0x61, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x52, 0x06, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x73, 0x1a, 0x50, 0x0a, 0x0b, 0x50, 0x6c, 0x61, 0x63,
+	0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2b, 0x0a, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63,
+	0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x56, 0x61, 0x72, 0x73, 0x52,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x34, 0x0a, 0x1c, 0x47, 0x65,
+	0x74, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x56, 0x61, 0x72, 0x73, 0x55, 0x6e,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x63,
+	0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x64, 0x63, 0x69, 0x64, 0x73,
+	0x22, 0x53, 0x0a, 0x1d, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74,
+	0x56, 0x61, 0x72, 0x73, 0x55, 0x6e, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x32, 0x0a, 0x09, 0x73, 0x74, 0x61, 0x74, 0x5f, 0x76, 0x61, 0x72, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f,
+	0x6e, 0x73, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x56, 0x61, 0x72, 0x73, 0x52, 0x08, 0x73, 0x74, 0x61,
+	0x74, 0x56, 0x61, 0x72, 0x73, 0x22, 0x3e, 0x0a, 0x1f, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x63,
+	0x65, 0x53, 0x74, 0x61, 0x74, 0x56, 0x61, 0x72, 0x73, 0x55, 0x6e, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x31, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x74, 0x61, 0x74,
+	0x5f, 0x76, 0x61, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x73, 0x74, 0x61,
+	0x74, 0x56, 0x61, 0x72, 0x73, 0x22, 0xc7, 0x01, 0x0a, 0x0d, 0x53, 0x74, 0x61, 0x74, 0x56, 0x61,
+	0x72, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x12, 0x55, 0x0a, 0x0f, 0x73, 0x74, 0x61, 0x74, 0x5f,
+	0x76, 0x61, 0x72, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x2d, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x53,
+	0x74, 0x61, 0x74, 0x56, 0x61, 0x72, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x2e, 0x53, 0x74, 0x61,
+	0x74, 0x56, 0x61, 0x72, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
+	0x0d, 0x73, 0x74, 0x61, 0x74, 0x56, 0x61, 0x72, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x1a, 0x5f,
+	0x0a, 0x12, 0x53, 0x74, 0x61, 0x74, 0x56, 0x61, 0x72, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x33, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d,
+	0x6f, 0x6e, 0x73, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x56, 0x61, 0x72, 0x47, 0x72, 0x6f, 0x75, 0x70,
+	0x4e, 0x6f, 0x64, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22,
+	0x9c, 0x04, 0x0a, 0x10, 0x53, 0x74, 0x61, 0x74, 0x56, 0x61, 0x72, 0x47, 0x72, 0x6f, 0x75, 0x70,
+	0x4e, 0x6f, 0x64, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x62, 0x73, 0x6f, 0x6c, 0x75, 0x74, 0x65,
+	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x61, 0x62, 0x73,
+	0x6f, 0x6c, 0x75, 0x74, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x4d, 0x0a, 0x0f, 0x63, 0x68, 0x69,
+	0x6c, 0x64, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x5f, 0x76, 0x61, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x25, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73,
+	0x2e, 0x53, 0x74, 0x61, 0x74, 0x56, 0x61, 0x72, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4e, 0x6f, 0x64,
+	0x65, 0x2e, 0x43, 0x68, 0x69, 0x6c, 0x64, 0x53, 0x56, 0x52, 0x0d, 0x63, 0x68, 0x69, 0x6c, 0x64,
+	0x53, 0x74, 0x61, 0x74, 0x56, 0x61, 0x72, 0x73, 0x12, 0x59, 0x0a, 0x15, 0x63, 0x68, 0x69, 0x6c,
+	0x64, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x5f, 0x76, 0x61, 0x72, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70,
+	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f,
+	0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x56, 0x61, 0x72, 0x47, 0x72, 0x6f,
+	0x75, 0x70, 0x4e, 0x6f, 0x64, 0x65, 0x2e, 0x43, 0x68, 0x69, 0x6c, 0x64, 0x53, 0x56, 0x47, 0x52,
+	0x12, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x53, 0x74, 0x61, 0x74, 0x56, 0x61, 0x72, 0x47, 0x72, 0x6f,
+	0x75, 0x70, 0x73, 0x12, 0x33, 0x0a, 0x16, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x74,
+	0x61, 0x74, 0x5f, 0x76, 0x61, 0x72, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x18, 0x04, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x13, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x56,
+	0x61, 0x72, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x12, 0x37, 0x0a, 0x18, 0x6e, 0x75, 0x6d, 0x5f,
+	0x64, 0x65, 0x73, 0x63, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x5f,
+	0x76, 0x61, 0x72, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x15, 0x6e, 0x75, 0x6d, 0x44,
+	0x65, 0x73, 0x63, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x56, 0x61, 0x72,
+	0x73, 0x1a, 0x6c, 0x0a, 0x08, 0x43, 0x68, 0x69, 0x6c, 0x64, 0x53, 0x56, 0x47, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x2d, 0x0a,
+	0x12, 0x73, 0x70, 0x65, 0x63, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x5f, 0x65, 0x6e, 0x74,
+	0x69, 0x74, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x73, 0x70, 0x65, 0x63, 0x69,
+	0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x21, 0x0a, 0x0c,
+	0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x1a,
+	0x5d, 0x0a, 0x07, 0x43, 0x68, 0x69, 0x6c, 0x64, 0x53, 0x56, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x65,
+	0x61, 0x72, 0x63, 0x68, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x64,
+	0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x36,
+	0x0a, 0x16, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x56, 0x61, 0x72, 0x47, 0x72, 0x6f, 0x75,
+	0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x6c, 0x61, 0x63,
+	0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x73,
+	0x4a, 0x04, 0x08, 0x01, 0x10, 0x02, 0x22, 0x86, 0x01, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x53, 0x74,
+	0x61, 0x74, 0x56, 0x61, 0x72, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x0e, 0x73, 0x74, 0x61, 0x74, 0x5f, 0x76, 0x61,
+	0x72, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73,
+	0x74, 0x61, 0x74, 0x56, 0x61, 0x72, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x16, 0x0a, 0x06, 0x70,
+	0x6c, 0x61, 0x63, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x70, 0x6c, 0x61,
+	0x63, 0x65, 0x73, 0x12, 0x2a, 0x0a, 0x11, 0x72, 0x65, 0x61, 0x64, 0x5f, 0x66, 0x72, 0x6f, 0x6d,
+	0x5f, 0x74, 0x72, 0x69, 0x70, 0x6c, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f,
+	0x72, 0x65, 0x61, 0x64, 0x46, 0x72, 0x6f, 0x6d, 0x54, 0x72, 0x69, 0x70, 0x6c, 0x65, 0x73, 0x22,
+	0xa1, 0x02, 0x0a, 0x08, 0x53, 0x56, 0x4f, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x74, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x63, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x64, 0x63, 0x69, 0x64, 0x12, 0x3f, 0x0a, 0x0c, 0x6f, 0x62, 0x73, 0x65,
+	0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b,
+	0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x53, 0x56, 0x4f,
+	0x4f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x6f, 0x62, 0x73,
+	0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x70, 0x72, 0x6f,
+	0x76, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0c, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x12, 0x2e,
+	0x0a, 0x04, 0x74, 0x65, 0x6d, 0x70, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x64,
+	0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x53, 0x56, 0x4f, 0x50, 0x6c,
+	0x61, 0x63, 0x65, 0x2e, 0x54, 0x65, 0x6d, 0x70, 0x52, 0x04, 0x74, 0x65, 0x6d, 0x70, 0x1a, 0x43,
+	0x0a, 0x04, 0x54, 0x65, 0x6d, 0x70, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x5f,
+	0x70, 0x6c, 0x61, 0x63, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x68,
+	0x69, 0x6c, 0x64, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x69, 0x64,
+	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x6d, 0x69, 0x64, 0x73, 0x4a, 0x04, 0x08,
+	0x01, 0x10, 0x02, 0x22, 0x8c, 0x04, 0x0a, 0x0e, 0x53, 0x56, 0x4f, 0x4f, 0x62, 0x73, 0x65, 0x72,
+	0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x63, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x64, 0x63, 0x69, 0x64, 0x12, 0x29, 0x0a, 0x10, 0x6f, 0x62,
+	0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x6f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x44, 0x61, 0x74, 0x65, 0x12, 0x2d, 0x0a, 0x12, 0x6f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x11, 0x6f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x65,
+	0x72, 0x69, 0x6f, 0x64, 0x12, 0x2d, 0x0a, 0x12, 0x6d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x5f, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x11, 0x6d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x4d, 0x65, 0x74,
+	0x68, 0x6f, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x6e, 0x69, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x75, 0x6e, 0x69, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x63, 0x61, 0x6c, 0x69,
+	0x6e, 0x67, 0x5f, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0d, 0x73, 0x63, 0x61, 0x6c, 0x69, 0x6e, 0x67, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x23,
+	0x0a, 0x0d, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x6e, 0x61, 0x6e, 0x63,
+	0x65, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x09, 0x73, 0x74, 0x72, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x08, 0x73, 0x74, 0x72, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x12, 0x1d, 0x0a, 0x09, 0x64, 0x62, 0x6c, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x01, 0x48, 0x00, 0x52, 0x08, 0x64, 0x62, 0x6c, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x12, 0x34, 0x0a, 0x04, 0x74, 0x65, 0x6d, 0x70, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x20, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x53, 0x56,
+	0x4f, 0x4f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x54, 0x65, 0x6d,
+	0x70, 0x52, 0x04, 0x74, 0x65, 0x6d, 0x70, 0x1a, 0x81, 0x01, 0x0a, 0x04, 0x54, 0x65, 0x6d, 0x70,
+	0x12, 0x2b, 0x0a, 0x11, 0x6f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x61, 0x62, 0x6f, 0x75, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x6f, 0x62, 0x73,
+	0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x62, 0x6f, 0x75, 0x74, 0x12, 0x2b, 0x0a,
+	0x11, 0x76, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x6d, 0x65, 0x61, 0x73, 0x75, 0x72,
+	0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x76, 0x61, 0x72, 0x69, 0x61, 0x62,
+	0x6c, 0x65, 0x4d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6d,
+	0x70, 0x6f, 0x72, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x42, 0x05, 0x0a, 0x03, 0x76,
+	0x61, 0x6c, 0x22, 0x3e, 0x0a, 0x0d, 0x53, 0x56, 0x4f, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x2d, 0x0a, 0x06, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e,
+	0x73, 0x2e, 0x53, 0x56, 0x4f, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x52, 0x06, 0x70, 0x6c, 0x61, 0x63,
+	0x65, 0x73, 0x22, 0x85, 0x01, 0x0a, 0x0a, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x49, 0x6e, 0x66,
+	0x6f, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x79, 0x70, 0x65, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x74, 0x79, 0x70, 0x65, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x64,
+	0x63, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x64, 0x63, 0x69, 0x64, 0x12,
+	0x23, 0x0a, 0x0d, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x6e, 0x61, 0x6e,
+	0x63, 0x65, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x6c, 0x0a, 0x14, 0x45, 0x6e,
+	0x74, 0x69, 0x74, 0x79, 0x49, 0x6e, 0x66, 0x6f, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x33, 0x0a, 0x08, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f,
+	0x6e, 0x73, 0x2e, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x08, 0x65,
+	0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c,
+	0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x74, 0x6f,
+	0x74, 0x61, 0x6c, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x92, 0x01, 0x0a, 0x18, 0x43, 0x6f, 0x6e,
+	0x74, 0x61, 0x69, 0x6e, 0x65, 0x64, 0x49, 0x6e, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x52, 0x65, 0x6c,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74,
+	0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x79, 0x70,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x54,
+	0x79, 0x70, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x5f, 0x69, 0x64, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x49, 0x64, 0x12, 0x1d,
+	0x0a, 0x0a, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x54, 0x79, 0x70, 0x65, 0x22, 0xb6, 0x02,
+	0x0a, 0x06, 0x54, 0x72, 0x69, 0x70, 0x6c, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x75, 0x62, 0x6a,
+	0x65, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x75,
+	0x62, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x72, 0x65, 0x64, 0x69,
+	0x63, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x65, 0x64,
+	0x69, 0x63, 0x61, 0x74, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x5f,
+	0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74,
+	0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x4e,
+	0x61, 0x6d, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6f, 0x62, 0x6a, 0x65, 0x63,
+	0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x6e,
+	0x61, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x70,
+	0x72, 0x6f, 0x76, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x73,
+	0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x23,
+	0x0a, 0x0d, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x18,
+	0x08, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x54, 0x79,
+	0x70, 0x65, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x74, 0x79,
+	0x70, 0x65, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x6f, 0x62, 0x6a, 0x65, 0x63,
+	0x74, 0x54, 0x79, 0x70, 0x65, 0x73, 0x22, 0x59, 0x0a, 0x07, 0x54, 0x72, 0x69, 0x70, 0x6c, 0x65,
+	0x73, 0x12, 0x2d, 0x0a, 0x07, 0x74, 0x72, 0x69, 0x70, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x13, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73,
+	0x2e, 0x54, 0x72, 0x69, 0x70, 0x6c, 0x65, 0x52, 0x07, 0x74, 0x72, 0x69, 0x70, 0x6c, 0x65, 0x73,
+	0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x22, 0x6e, 0x0a, 0x0e, 0x50, 0x72, 0x6f, 0x76, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x49,
+	0x6e, 0x66, 0x6f, 0x12, 0x23, 0x0a, 0x0d, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x6e, 0x61, 0x6e, 0x63,
+	0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x70, 0x72, 0x6f, 0x76,
+	0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x6f, 0x6d, 0x61,
+	0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e,
+	0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x4e, 0x61, 0x6d,
+	0x65, 0x22, 0x4c, 0x0a, 0x0b, 0x50, 0x72, 0x6f, 0x76, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x73,
+	0x12, 0x3d, 0x0a, 0x0b, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d,
+	0x6f, 0x6e, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x76, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x6e,
+	0x66, 0x6f, 0x52, 0x0b, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x22,
+	0x4c, 0x0a, 0x0e, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x4c, 0x61, 0x62, 0x65, 0x6c,
+	0x73, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x6e, 0x5f, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x69, 0x6e, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x12, 0x1d,
+	0x0a, 0x0a, 0x6f, 0x75, 0x74, 0x5f, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x09, 0x6f, 0x75, 0x74, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x22, 0xa1, 0x04,
+	0x0a, 0x11, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x65, 0x64, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x73, 0x49,
+	0x6e, 0x66, 0x6f, 0x12, 0x25, 0x0a, 0x0e, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x70,
+	0x6c, 0x61, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x72, 0x65, 0x6c,
+	0x61, 0x74, 0x65, 0x64, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x73, 0x12, 0x22, 0x0a, 0x0d, 0x72, 0x61,
+	0x6e, 0x6b, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x74, 0x6f, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x0b, 0x72, 0x61, 0x6e, 0x6b, 0x46, 0x72, 0x6f, 0x6d, 0x54, 0x6f, 0x70, 0x12, 0x28,
+	0x0a, 0x10, 0x72, 0x61, 0x6e, 0x6b, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x62, 0x6f, 0x74, 0x74,
+	0x6f, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x72, 0x61, 0x6e, 0x6b, 0x46, 0x72,
+	0x6f, 0x6d, 0x42, 0x6f, 0x74, 0x74, 0x6f, 0x6d, 0x12, 0x41, 0x0a, 0x08, 0x72, 0x61, 0x6e, 0x6b,
+	0x5f, 0x61, 0x6c, 0x6c, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x64, 0x61, 0x74,
+	0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x65, 0x64,
+	0x50, 0x6c, 0x61, 0x63, 0x65, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x2e, 0x52, 0x61, 0x6e, 0x6b, 0x69,
+	0x6e, 0x67, 0x52, 0x07, 0x72, 0x61, 0x6e, 0x6b, 0x41, 0x6c, 0x6c, 0x12, 0x4a, 0x0a, 0x0d, 0x72,
+	0x61, 0x6e, 0x6b, 0x5f, 0x74, 0x6f, 0x70, 0x5f, 0x31, 0x30, 0x30, 0x30, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x26, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73,
+	0x2e, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x65, 0x64, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x73, 0x49, 0x6e,
+	0x66, 0x6f, 0x2e, 0x52, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x52, 0x0b, 0x72, 0x61, 0x6e, 0x6b,
+	0x54, 0x6f, 0x70, 0x31, 0x30, 0x30, 0x30, 0x12, 0x50, 0x0a, 0x10, 0x72, 0x61, 0x6e, 0x6b, 0x5f,
+	0x62, 0x6f, 0x74, 0x74, 0x6f, 0x6d, 0x5f, 0x31, 0x30, 0x30, 0x30, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x26, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e,
+	0x52, 0x65, 0x6c, 0x61, 0x74, 0x65, 0x64, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x73, 0x49, 0x6e, 0x66,
+	0x6f, 0x2e, 0x52, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x52, 0x0e, 0x72, 0x61, 0x6e, 0x6b, 0x42,
+	0x6f, 0x74, 0x74, 0x6f, 0x6d, 0x31, 0x30, 0x30, 0x30, 0x1a, 0xa3, 0x01, 0x0a, 0x07, 0x52, 0x61,
+	0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x12, 0x43, 0x0a, 0x04, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e,
+	0x73, 0x2e, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x65, 0x64, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x73, 0x49,
+	0x6e, 0x66, 0x6f, 0x2e, 0x52, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x2e, 0x52, 0x61, 0x6e, 0x6b,
+	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x04, 0x69, 0x6e, 0x66, 0x6f, 0x1a, 0x53, 0x0a, 0x08, 0x52, 0x61,
+	0x6e, 0x6b, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x5f, 0x64, 0x63, 0x69, 0x64, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x44, 0x63, 0x69, 0x64, 0x4a,
+	0x04, 0x08, 0x04, 0x10, 0x05, 0x4a, 0x04, 0x08, 0x05, 0x10, 0x06, 0x4a, 0x04, 0x08, 0x06, 0x10,
+	0x07, 0x22, 0x27, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x56, 0x61, 0x72, 0x50,
+	0x61, 0x74, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x2c, 0x0a, 0x16, 0x47, 0x65,
+	0x74, 0x53, 0x74, 0x61, 0x74, 0x56, 0x61, 0x72, 0x50, 0x61, 0x74, 0x68, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x22, 0x44, 0x0a, 0x14, 0x53, 0x65, 0x61, 0x72,
+	0x63, 0x68, 0x53, 0x74, 0x61, 0x74, 0x56, 0x61, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x14, 0x0a, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x73, 0x22, 0x8e,
+	0x01, 0x0a, 0x15, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x53, 0x74, 0x61, 0x74, 0x56, 0x61, 0x72,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x34, 0x0a, 0x09, 0x73, 0x74, 0x61, 0x74,
+	0x5f, 0x76, 0x61, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x64, 0x61,
+	0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79,
+	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x08, 0x73, 0x74, 0x61, 0x74, 0x56, 0x61, 0x72, 0x73, 0x12, 0x3f,
+	0x0a, 0x0f, 0x73, 0x74, 0x61, 0x74, 0x5f, 0x76, 0x61, 0x72, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f,
+	0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x49, 0x6e, 0x66, 0x6f,
+	0x52, 0x0d, 0x73, 0x74, 0x61, 0x74, 0x56, 0x61, 0x72, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x32,
+	0xdc, 0x1f, 0x0a, 0x05, 0x4d, 0x69, 0x78, 0x65, 0x72, 0x12, 0x5b, 0x0a, 0x05, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x12, 0x19, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73,
+	0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e,
+	0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72,
+	0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1b, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x15, 0x12, 0x06, 0x2f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x5a, 0x0b, 0x22, 0x06, 0x2f, 0x71, 0x75,
+	0x65, 0x72, 0x79, 0x3a, 0x01, 0x2a, 0x12, 0x66, 0x0a, 0x0b, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x19, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d,
+	0x6f, 0x6e, 0x73, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x20, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x18, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x12, 0x22, 0x0d, 0x2f, 0x71, 0x75, 0x65,
+	0x72, 0x79, 0x2f, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x3a, 0x01, 0x2a, 0x30, 0x01, 0x12, 0x9d,
+	0x01, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x4c, 0x61,
+	0x62, 0x65, 0x6c, 0x73, 0x12, 0x25, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f,
+	0x6e, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x4c, 0x61,
+	0x62, 0x65, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x64, 0x61,
+	0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f,
+	0x70, 0x65, 0x72, 0x74, 0x79, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x39, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x33, 0x12, 0x15, 0x2f, 0x6e, 0x6f,
+	0x64, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x2d, 0x6c, 0x61, 0x62, 0x65,
+	0x6c, 0x73, 0x5a, 0x1a, 0x22, 0x15, 0x2f, 0x6e, 0x6f, 0x64, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x70,
+	0x65, 0x72, 0x74, 0x79, 0x2d, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x3a, 0x01, 0x2a, 0x12, 0x9d,
+	0x01, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x73, 0x12, 0x25, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f,
+	0x6e, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x64, 0x61,
+	0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f,
+	0x70, 0x65, 0x72, 0x74, 0x79, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x39, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x33, 0x12, 0x15, 0x2f, 0x6e, 0x6f,
+	0x64, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x2d, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x73, 0x5a, 0x1a, 0x22, 0x15, 0x2f, 0x6e, 0x6f, 0x64, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x70,
+	0x65, 0x72, 0x74, 0x79, 0x2d, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x3a, 0x01, 0x2a, 0x12, 0x78,
+	0x0a, 0x0a, 0x47, 0x65, 0x74, 0x54, 0x72, 0x69, 0x70, 0x6c, 0x65, 0x73, 0x12, 0x1e, 0x2e, 0x64,
+	0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x72,
+	0x69, 0x70, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x64,
+	0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x72,
+	0x69, 0x70, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x29, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x23, 0x12, 0x0d, 0x2f, 0x6e, 0x6f, 0x64, 0x65, 0x2f, 0x74, 0x72, 0x69,
+	0x70, 0x6c, 0x65, 0x73, 0x5a, 0x12, 0x22, 0x0d, 0x2f, 0x6e, 0x6f, 0x64, 0x65, 0x2f, 0x74, 0x72,
+	0x69, 0x70, 0x6c, 0x65, 0x73, 0x3a, 0x01, 0x2a, 0x12, 0x7f, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x50,
+	0x6c, 0x61, 0x63, 0x65, 0x73, 0x49, 0x6e, 0x12, 0x1f, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f,
+	0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x73, 0x49,
+	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63,
+	0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x73,
+	0x49, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2d, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x27, 0x12, 0x0f, 0x2f, 0x6e, 0x6f, 0x64, 0x65, 0x2f, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x73,
+	0x2d, 0x69, 0x6e, 0x5a, 0x14, 0x22, 0x0f, 0x2f, 0x6e, 0x6f, 0x64, 0x65, 0x2f, 0x70, 0x6c, 0x61,
+	0x63, 0x65, 0x73, 0x2d, 0x69, 0x6e, 0x3a, 0x01, 0x2a, 0x12, 0x79, 0x0a, 0x0b, 0x47, 0x65, 0x74,
+	0x50, 0x6c, 0x61, 0x63, 0x65, 0x4f, 0x62, 0x73, 0x12, 0x1f, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63,
+	0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x4f,
+	0x62, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x64, 0x61, 0x74, 0x61,
+	0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x53, 0x56, 0x4f, 0x43, 0x6f, 0x6c, 0x6c, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x2d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x27, 0x12, 0x0f, 0x2f,
+	0x62, 0x75, 0x6c, 0x6b, 0x2f, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x2d, 0x6f, 0x62, 0x73, 0x5a, 0x14,
+	0x22, 0x0f, 0x2f, 0x62, 0x75, 0x6c, 0x6b, 0x2f, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x2d, 0x6f, 0x62,
+	0x73, 0x3a, 0x01, 0x2a, 0x12, 0x6e, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73,
+	0x12, 0x1c, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x47,
+	0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d,
 	0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x47, 0x65, 0x74,
-	0x54, 0x72, 0x69, 0x70, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x29, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x23, 0x12, 0x0d, 0x2f, 0x6e, 0x6f, 0x64, 0x65, 0x2f, 0x74,
-	0x72, 0x69, 0x70, 0x6c, 0x65, 0x73, 0x5a, 0x12, 0x22, 0x0d, 0x2f, 0x6e, 0x6f, 0x64, 0x65, 0x2f,
-	0x74, 0x72, 0x69, 0x70, 0x6c, 0x65, 0x73, 0x3a, 0x01, 0x2a, 0x12, 0x7f, 0x0a, 0x0b, 0x47, 0x65,
-	0x74, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x73, 0x49, 0x6e, 0x12, 0x1f, 0x2e, 0x64, 0x61, 0x74, 0x61,
-	0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x63, 0x65,
-	0x73, 0x49, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x64, 0x61, 0x74,
-	0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x63,
-	0x65, 0x73, 0x49, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2d, 0x82, 0xd3,
-	0xe4, 0x93, 0x02, 0x27, 0x12, 0x0f, 0x2f, 0x6e, 0x6f, 0x64, 0x65, 0x2f, 0x70, 0x6c, 0x61, 0x63,
-	0x65, 0x73, 0x2d, 0x69, 0x6e, 0x5a, 0x14, 0x22, 0x0f, 0x2f, 0x6e, 0x6f, 0x64, 0x65, 0x2f, 0x70,
-	0x6c, 0x61, 0x63, 0x65, 0x73, 0x2d, 0x69, 0x6e, 0x3a, 0x01, 0x2a, 0x12, 0x79, 0x0a, 0x0b, 0x47,
-	0x65, 0x74, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x4f, 0x62, 0x73, 0x12, 0x1f, 0x2e, 0x64, 0x61, 0x74,
-	0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x63,
-	0x65, 0x4f, 0x62, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x64, 0x61,
-	0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x53, 0x56, 0x4f, 0x43, 0x6f, 0x6c,
-	0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x2d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x27, 0x12,
-	0x0f, 0x2f, 0x62, 0x75, 0x6c, 0x6b, 0x2f, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x2d, 0x6f, 0x62, 0x73,
-	0x5a, 0x14, 0x22, 0x0f, 0x2f, 0x62, 0x75, 0x6c, 0x6b, 0x2f, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x2d,
-	0x6f, 0x62, 0x73, 0x3a, 0x01, 0x2a, 0x12, 0x6e, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61,
-	0x74, 0x73, 0x12, 0x1c, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73,
-	0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x1d, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x47,
-	0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x25, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1f, 0x12, 0x0b, 0x2f, 0x62, 0x75, 0x6c, 0x6b, 0x2f, 0x73,
-	0x74, 0x61, 0x74, 0x73, 0x5a, 0x10, 0x22, 0x0b, 0x2f, 0x62, 0x75, 0x6c, 0x6b, 0x2f, 0x73, 0x74,
-	0x61, 0x74, 0x73, 0x3a, 0x01, 0x2a, 0x12, 0x96, 0x01, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x53, 0x74,
-	0x61, 0x74, 0x53, 0x65, 0x74, 0x53, 0x65, 0x72, 0x69, 0x65, 0x73, 0x12, 0x24, 0x2e, 0x64, 0x61,
-	0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61,
-	0x74, 0x53, 0x65, 0x74, 0x53, 0x65, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x25, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e,
-	0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x53, 0x65, 0x74, 0x53, 0x65, 0x72, 0x69, 0x65, 0x73,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x35, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2f,
-	0x12, 0x13, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x2f, 0x73, 0x65, 0x74, 0x2f, 0x73,
-	0x65, 0x72, 0x69, 0x65, 0x73, 0x5a, 0x18, 0x22, 0x13, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x74, 0x61,
-	0x74, 0x2f, 0x73, 0x65, 0x74, 0x2f, 0x73, 0x65, 0x72, 0x69, 0x65, 0x73, 0x3a, 0x01, 0x2a, 0x12,
-	0x7a, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12,
-	0x20, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x47, 0x65,
-	0x74, 0x53, 0x74, 0x61, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x21, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e,
-	0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x25, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1f, 0x12, 0x0b, 0x2f, 0x73,
-	0x74, 0x61, 0x74, 0x2f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x5a, 0x10, 0x22, 0x0b, 0x2f, 0x73, 0x74,
-	0x61, 0x74, 0x2f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x01, 0x2a, 0x12, 0x7f, 0x0a, 0x0d, 0x47,
-	0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x53, 0x65, 0x72, 0x69, 0x65, 0x73, 0x12, 0x21, 0x2e, 0x64,
-	0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74,
-	0x61, 0x74, 0x53, 0x65, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x22, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x47, 0x65,
-	0x74, 0x53, 0x74, 0x61, 0x74, 0x53, 0x65, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x27, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x21, 0x12, 0x0c, 0x2f, 0x73, 0x74,
-	0x61, 0x74, 0x2f, 0x73, 0x65, 0x72, 0x69, 0x65, 0x73, 0x5a, 0x11, 0x22, 0x0c, 0x2f, 0x73, 0x74,
-	0x61, 0x74, 0x2f, 0x73, 0x65, 0x72, 0x69, 0x65, 0x73, 0x3a, 0x01, 0x2a, 0x12, 0x70, 0x0a, 0x0a,
-	0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x41, 0x6c, 0x6c, 0x12, 0x1e, 0x2e, 0x64, 0x61, 0x74,
+	0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x25, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x1f, 0x12, 0x0b, 0x2f, 0x62, 0x75, 0x6c, 0x6b, 0x2f, 0x73, 0x74, 0x61,
+	0x74, 0x73, 0x5a, 0x10, 0x22, 0x0b, 0x2f, 0x62, 0x75, 0x6c, 0x6b, 0x2f, 0x73, 0x74, 0x61, 0x74,
+	0x73, 0x3a, 0x01, 0x2a, 0x12, 0x96, 0x01, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74,
+	0x53, 0x65, 0x74, 0x53, 0x65, 0x72, 0x69, 0x65, 0x73, 0x12, 0x24, 0x2e, 0x64, 0x61, 0x74, 0x61,
+	0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x53,
+	0x65, 0x74, 0x53, 0x65, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x25, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x47, 0x65,
+	0x74, 0x53, 0x74, 0x61, 0x74, 0x53, 0x65, 0x74, 0x53, 0x65, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x35, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2f, 0x12, 0x13,
+	0x2f, 0x76, 0x31, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x2f, 0x73, 0x65, 0x74, 0x2f, 0x73, 0x65, 0x72,
+	0x69, 0x65, 0x73, 0x5a, 0x18, 0x22, 0x13, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x2f,
+	0x73, 0x65, 0x74, 0x2f, 0x73, 0x65, 0x72, 0x69, 0x65, 0x73, 0x3a, 0x01, 0x2a, 0x12, 0x7a, 0x0a,
+	0x0c, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x20, 0x2e,
+	0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x53,
+	0x74, 0x61, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x21, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x47, 0x65,
+	0x74, 0x53, 0x74, 0x61, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x25, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1f, 0x12, 0x0b, 0x2f, 0x73, 0x74, 0x61,
+	0x74, 0x2f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x5a, 0x10, 0x22, 0x0b, 0x2f, 0x73, 0x74, 0x61, 0x74,
+	0x2f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x01, 0x2a, 0x12, 0x7f, 0x0a, 0x0d, 0x47, 0x65, 0x74,
+	0x53, 0x74, 0x61, 0x74, 0x53, 0x65, 0x72, 0x69, 0x65, 0x73, 0x12, 0x21, 0x2e, 0x64, 0x61, 0x74,
 	0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74,
-	0x41, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x64, 0x61, 0x74,
-	0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74,
-	0x41, 0x6c, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x21, 0x82, 0xd3, 0xe4,
-	0x93, 0x02, 0x1b, 0x12, 0x09, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x2f, 0x61, 0x6c, 0x6c, 0x5a, 0x0e,
-	0x22, 0x09, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x2f, 0x61, 0x6c, 0x6c, 0x3a, 0x01, 0x2a, 0x12, 0xa0,
-	0x01, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x53, 0x65, 0x74, 0x57, 0x69, 0x74,
-	0x68, 0x69, 0x6e, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x12, 0x29, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63,
-	0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x53, 0x65,
-	0x74, 0x57, 0x69, 0x74, 0x68, 0x69, 0x6e, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e,
-	0x73, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x53, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x35, 0x12, 0x16, 0x2f, 0x73,
-	0x74, 0x61, 0x74, 0x2f, 0x73, 0x65, 0x74, 0x2f, 0x77, 0x69, 0x74, 0x68, 0x69, 0x6e, 0x2d, 0x70,
-	0x6c, 0x61, 0x63, 0x65, 0x5a, 0x1b, 0x22, 0x16, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x2f, 0x73, 0x65,
-	0x74, 0x2f, 0x77, 0x69, 0x74, 0x68, 0x69, 0x6e, 0x2d, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x3a, 0x01,
-	0x2a, 0x12, 0x70, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x53, 0x65, 0x74, 0x12,
-	0x1e, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x47, 0x65,
-	0x74, 0x53, 0x74, 0x61, 0x74, 0x53, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x1f, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x47, 0x65,
-	0x74, 0x53, 0x74, 0x61, 0x74, 0x53, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x21, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1b, 0x12, 0x09, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x2f,
-	0x73, 0x65, 0x74, 0x5a, 0x0e, 0x22, 0x09, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x2f, 0x73, 0x65, 0x74,
-	0x3a, 0x01, 0x2a, 0x12, 0xaa, 0x01, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x4c, 0x6f, 0x63, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x73, 0x52, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x28, 0x2e, 0x64,
-	0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x6f,
-	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d,
-	0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x73, 0x52, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x3d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x37, 0x12, 0x17, 0x2f, 0x6e, 0x6f, 0x64, 0x65,
-	0x2f, 0x72, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x2d, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x73, 0x5a, 0x1c, 0x22, 0x17, 0x2f, 0x6e, 0x6f, 0x64, 0x65, 0x2f, 0x72, 0x61, 0x6e, 0x6b,
-	0x69, 0x6e, 0x67, 0x2d, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x3a, 0x01, 0x2a,
-	0x12, 0xa7, 0x01, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x65, 0x64, 0x4c,
-	0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x27, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63,
-	0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x65,
-	0x64, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x28, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e,
-	0x47, 0x65, 0x74, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x65, 0x64, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3d, 0x82, 0xd3, 0xe4,
-	0x93, 0x02, 0x37, 0x12, 0x17, 0x2f, 0x6e, 0x6f, 0x64, 0x65, 0x2f, 0x72, 0x65, 0x6c, 0x61, 0x74,
-	0x65, 0x64, 0x2d, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x5a, 0x1c, 0x22, 0x17,
-	0x2f, 0x6e, 0x6f, 0x64, 0x65, 0x2f, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x65, 0x64, 0x2d, 0x6c, 0x6f,
-	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x3a, 0x01, 0x2a, 0x12, 0x90, 0x01, 0x0a, 0x12, 0x47,
-	0x65, 0x74, 0x4c, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x50, 0x61, 0x67, 0x65, 0x44, 0x61, 0x74,
-	0x61, 0x12, 0x26, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e,
-	0x47, 0x65, 0x74, 0x4c, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x50, 0x61, 0x67, 0x65, 0x44, 0x61,
-	0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x64, 0x61, 0x74, 0x61,
-	0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x61, 0x6e, 0x64, 0x69,
-	0x6e, 0x67, 0x50, 0x61, 0x67, 0x65, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x29, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x23, 0x12, 0x0d, 0x2f, 0x6c, 0x61, 0x6e,
-	0x64, 0x69, 0x6e, 0x67, 0x2d, 0x70, 0x61, 0x67, 0x65, 0x5a, 0x12, 0x22, 0x0d, 0x2f, 0x6c, 0x61,
-	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x2d, 0x70, 0x61, 0x67, 0x65, 0x3a, 0x01, 0x2a, 0x12, 0x6f, 0x0a,
-	0x09, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74, 0x65, 0x12, 0x1d, 0x2e, 0x64, 0x61, 0x74,
-	0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6c, 0x61,
-	0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x64, 0x61, 0x74, 0x61,
-	0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74,
-	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x23, 0x82, 0xd3, 0xe4, 0x93, 0x02,
-	0x1d, 0x12, 0x0a, 0x2f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74, 0x65, 0x5a, 0x0f, 0x22,
-	0x0a, 0x2f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74, 0x65, 0x3a, 0x01, 0x2a, 0x12, 0x52,
-	0x0a, 0x06, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x12, 0x1a, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63,
-	0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f,
-	0x6e, 0x73, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x0f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x09, 0x12, 0x07, 0x2f, 0x73, 0x65, 0x61, 0x72,
-	0x63, 0x68, 0x12, 0x5f, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
-	0x12, 0x1e, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x47,
-	0x65, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x1f, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x47,
-	0x65, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x10, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0a, 0x12, 0x08, 0x2f, 0x76, 0x65, 0x72, 0x73,
-	0x69, 0x6f, 0x6e, 0x12, 0x90, 0x01, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x63, 0x65,
-	0x53, 0x74, 0x61, 0x74, 0x73, 0x56, 0x61, 0x72, 0x12, 0x24, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63,
-	0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x53,
-	0x74, 0x61, 0x74, 0x73, 0x56, 0x61, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25,
+	0x53, 0x65, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e,
+	0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x53,
+	0x74, 0x61, 0x74, 0x53, 0x65, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x27, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x21, 0x12, 0x0c, 0x2f, 0x73, 0x74, 0x61, 0x74,
+	0x2f, 0x73, 0x65, 0x72, 0x69, 0x65, 0x73, 0x5a, 0x11, 0x22, 0x0c, 0x2f, 0x73, 0x74, 0x61, 0x74,
+	0x2f, 0x73, 0x65, 0x72, 0x69, 0x65, 0x73, 0x3a, 0x01, 0x2a, 0x12, 0x70, 0x0a, 0x0a, 0x47, 0x65,
+	0x74, 0x53, 0x74, 0x61, 0x74, 0x41, 0x6c, 0x6c, 0x12, 0x1e, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63,
+	0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x41, 0x6c,
+	0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63,
+	0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x41, 0x6c,
+	0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x21, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x1b, 0x12, 0x09, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x2f, 0x61, 0x6c, 0x6c, 0x5a, 0x0e, 0x22, 0x09,
+	0x2f, 0x73, 0x74, 0x61, 0x74, 0x2f, 0x61, 0x6c, 0x6c, 0x3a, 0x01, 0x2a, 0x12, 0xa0, 0x01, 0x0a,
+	0x15, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x53, 0x65, 0x74, 0x57, 0x69, 0x74, 0x68, 0x69,
+	0x6e, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x12, 0x29, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d,
+	0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x53, 0x65, 0x74, 0x57,
+	0x69, 0x74, 0x68, 0x69, 0x6e, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1f, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e,
+	0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x53, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x3b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x35, 0x12, 0x16, 0x2f, 0x73, 0x74, 0x61,
+	0x74, 0x2f, 0x73, 0x65, 0x74, 0x2f, 0x77, 0x69, 0x74, 0x68, 0x69, 0x6e, 0x2d, 0x70, 0x6c, 0x61,
+	0x63, 0x65, 0x5a, 0x1b, 0x22, 0x16, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x2f, 0x73, 0x65, 0x74, 0x2f,
+	0x77, 0x69, 0x74, 0x68, 0x69, 0x6e, 0x2d, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x3a, 0x01, 0x2a, 0x12,
+	0x70, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x53, 0x65, 0x74, 0x12, 0x1e, 0x2e,
+	0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x53,
+	0x74, 0x61, 0x74, 0x53, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e,
+	0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x53,
+	0x74, 0x61, 0x74, 0x53, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x21,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1b, 0x12, 0x09, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x2f, 0x73, 0x65,
+	0x74, 0x5a, 0x0e, 0x22, 0x09, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x2f, 0x73, 0x65, 0x74, 0x3a, 0x01,
+	0x2a, 0x12, 0xaa, 0x01, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x52, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x28, 0x2e, 0x64, 0x61, 0x74,
+	0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x6f, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f,
+	0x6e, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52,
+	0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x3d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x37, 0x12, 0x17, 0x2f, 0x6e, 0x6f, 0x64, 0x65, 0x2f, 0x72,
+	0x61, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x2d, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x5a, 0x1c, 0x22, 0x17, 0x2f, 0x6e, 0x6f, 0x64, 0x65, 0x2f, 0x72, 0x61, 0x6e, 0x6b, 0x69, 0x6e,
+	0x67, 0x2d, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x3a, 0x01, 0x2a, 0x12, 0xa7,
+	0x01, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x65, 0x64, 0x4c, 0x6f, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x27, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d,
+	0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x65, 0x64, 0x4c,
+	0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x28, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x47, 0x65,
+	0x74, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x65, 0x64, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3d, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x37, 0x12, 0x17, 0x2f, 0x6e, 0x6f, 0x64, 0x65, 0x2f, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x65, 0x64,
+	0x2d, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x5a, 0x1c, 0x22, 0x17, 0x2f, 0x6e,
+	0x6f, 0x64, 0x65, 0x2f, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x65, 0x64, 0x2d, 0x6c, 0x6f, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x3a, 0x01, 0x2a, 0x12, 0x90, 0x01, 0x0a, 0x12, 0x47, 0x65, 0x74,
+	0x4c, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x50, 0x61, 0x67, 0x65, 0x44, 0x61, 0x74, 0x61, 0x12,
+	0x26, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x47, 0x65,
+	0x74, 0x4c, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x50, 0x61, 0x67, 0x65, 0x44, 0x61, 0x74, 0x61,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f,
+	0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67,
+	0x50, 0x61, 0x67, 0x65, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x29, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x23, 0x12, 0x0d, 0x2f, 0x6c, 0x61, 0x6e, 0x64, 0x69,
+	0x6e, 0x67, 0x2d, 0x70, 0x61, 0x67, 0x65, 0x5a, 0x12, 0x22, 0x0d, 0x2f, 0x6c, 0x61, 0x6e, 0x64,
+	0x69, 0x6e, 0x67, 0x2d, 0x70, 0x61, 0x67, 0x65, 0x3a, 0x01, 0x2a, 0x12, 0x6f, 0x0a, 0x09, 0x54,
+	0x72, 0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74, 0x65, 0x12, 0x1d, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63,
+	0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f,
+	0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x23, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1d, 0x12,
+	0x0a, 0x2f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74, 0x65, 0x5a, 0x0f, 0x22, 0x0a, 0x2f,
+	0x74, 0x72, 0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74, 0x65, 0x3a, 0x01, 0x2a, 0x12, 0x52, 0x0a, 0x06,
+	0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x12, 0x1a, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d,
+	0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73,
+	0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x0f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x09, 0x12, 0x07, 0x2f, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68,
+	0x12, 0x5f, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1e,
 	0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x47, 0x65, 0x74,
-	0x50, 0x6c, 0x61, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x56, 0x61, 0x72, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x29, 0x12, 0x10, 0x2f,
-	0x70, 0x6c, 0x61, 0x63, 0x65, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x73, 0x2d, 0x76, 0x61, 0x72, 0x5a,
-	0x15, 0x22, 0x10, 0x2f, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x73, 0x2d,
-	0x76, 0x61, 0x72, 0x3a, 0x01, 0x2a, 0x12, 0x90, 0x01, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x50, 0x6c,
-	0x61, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x56, 0x61, 0x72, 0x73, 0x12, 0x24, 0x2e, 0x64, 0x61,
-	0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61,
-	0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x56, 0x61, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x25, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e,
-	0x47, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x56, 0x61, 0x72, 0x73,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x29,
-	0x12, 0x10, 0x2f, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x2d, 0x76, 0x61,
-	0x72, 0x73, 0x5a, 0x15, 0x22, 0x10, 0x2f, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x2f, 0x73, 0x74, 0x61,
-	0x74, 0x2d, 0x76, 0x61, 0x72, 0x73, 0x3a, 0x01, 0x2a, 0x12, 0xb5, 0x01, 0x0a, 0x17, 0x47, 0x65,
-	0x74, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x56, 0x61, 0x72, 0x73, 0x55, 0x6e,
-	0x69, 0x6f, 0x6e, 0x56, 0x31, 0x12, 0x29, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d,
-	0x6f, 0x6e, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74,
-	0x56, 0x61, 0x72, 0x73, 0x55, 0x6e, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x2c, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x47,
-	0x65, 0x74, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x56, 0x61, 0x72, 0x73, 0x55,
-	0x6e, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x31, 0x22, 0x41,
-	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x3b, 0x12, 0x19, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x6c, 0x61, 0x63,
-	0x65, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x2d, 0x76, 0x61, 0x72, 0x73, 0x2f, 0x75, 0x6e, 0x69, 0x6f,
-	0x6e, 0x5a, 0x1e, 0x22, 0x19, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x2f, 0x73,
-	0x74, 0x61, 0x74, 0x2d, 0x76, 0x61, 0x72, 0x73, 0x2f, 0x75, 0x6e, 0x69, 0x6f, 0x6e, 0x3a, 0x01,
-	0x2a, 0x12, 0xab, 0x01, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x53, 0x74,
-	0x61, 0x74, 0x56, 0x61, 0x72, 0x73, 0x55, 0x6e, 0x69, 0x6f, 0x6e, 0x12, 0x29, 0x2e, 0x64, 0x61,
-	0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61,
-	0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x56, 0x61, 0x72, 0x73, 0x55, 0x6e, 0x69, 0x6f, 0x6e, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d,
+	0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f,
+	0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x47, 0x65, 0x74,
+	0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x10, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0a, 0x12, 0x08, 0x2f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x12, 0x90, 0x01, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x53, 0x74,
+	0x61, 0x74, 0x73, 0x56, 0x61, 0x72, 0x12, 0x24, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d,
 	0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x53, 0x74, 0x61,
-	0x74, 0x56, 0x61, 0x72, 0x73, 0x55, 0x6e, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x3b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x35, 0x12, 0x16, 0x2f, 0x70, 0x6c, 0x61,
-	0x63, 0x65, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x2d, 0x76, 0x61, 0x72, 0x73, 0x2f, 0x75, 0x6e, 0x69,
-	0x6f, 0x6e, 0x5a, 0x1b, 0x22, 0x16, 0x2f, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x2f, 0x73, 0x74, 0x61,
+	0x74, 0x73, 0x56, 0x61, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x64,
+	0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6c,
+	0x61, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x56, 0x61, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x2f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x29, 0x12, 0x10, 0x2f, 0x70, 0x6c,
+	0x61, 0x63, 0x65, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x73, 0x2d, 0x76, 0x61, 0x72, 0x5a, 0x15, 0x22,
+	0x10, 0x2f, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x73, 0x2d, 0x76, 0x61,
+	0x72, 0x3a, 0x01, 0x2a, 0x12, 0x90, 0x01, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x63,
+	0x65, 0x53, 0x74, 0x61, 0x74, 0x56, 0x61, 0x72, 0x73, 0x12, 0x24, 0x2e, 0x64, 0x61, 0x74, 0x61,
+	0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x63, 0x65,
+	0x53, 0x74, 0x61, 0x74, 0x56, 0x61, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x25, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x47, 0x65,
+	0x74, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x56, 0x61, 0x72, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x29, 0x12, 0x10,
+	0x2f, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x2d, 0x76, 0x61, 0x72, 0x73,
+	0x5a, 0x15, 0x22, 0x10, 0x2f, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x2d,
+	0x76, 0x61, 0x72, 0x73, 0x3a, 0x01, 0x2a, 0x12, 0xb5, 0x01, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x50,
+	0x6c, 0x61, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x56, 0x61, 0x72, 0x73, 0x55, 0x6e, 0x69, 0x6f,
+	0x6e, 0x56, 0x31, 0x12, 0x29, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e,
+	0x73, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x56, 0x61,
+	0x72, 0x73, 0x55, 0x6e, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c,
+	0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x47, 0x65, 0x74,
+	0x50, 0x6c, 0x61, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x56, 0x61, 0x72, 0x73, 0x55, 0x6e, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x31, 0x22, 0x41, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x3b, 0x12, 0x19, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x2f,
+	0x73, 0x74, 0x61, 0x74, 0x2d, 0x76, 0x61, 0x72, 0x73, 0x2f, 0x75, 0x6e, 0x69, 0x6f, 0x6e, 0x5a,
+	0x1e, 0x22, 0x19, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x2f, 0x73, 0x74, 0x61,
 	0x74, 0x2d, 0x76, 0x61, 0x72, 0x73, 0x2f, 0x75, 0x6e, 0x69, 0x6f, 0x6e, 0x3a, 0x01, 0x2a, 0x12,
-	0xcb, 0x01, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74,
-	0x44, 0x61, 0x74, 0x65, 0x57, 0x69, 0x74, 0x68, 0x69, 0x6e, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x12,
-	0x2f, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x47, 0x65,
-	0x74, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x44, 0x61, 0x74, 0x65, 0x57, 0x69,
-	0x74, 0x68, 0x69, 0x6e, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x30, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x47,
-	0x65, 0x74, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x44, 0x61, 0x74, 0x65, 0x57,
-	0x69, 0x74, 0x68, 0x69, 0x6e, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x49, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x43, 0x12, 0x1d, 0x2f, 0x70, 0x6c, 0x61,
-	0x63, 0x65, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x2f, 0x64, 0x61, 0x74, 0x65, 0x2f, 0x77, 0x69, 0x74,
-	0x68, 0x69, 0x6e, 0x2d, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x5a, 0x22, 0x22, 0x1d, 0x2f, 0x70, 0x6c,
-	0x61, 0x63, 0x65, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x2f, 0x64, 0x61, 0x74, 0x65, 0x2f, 0x77, 0x69,
-	0x74, 0x68, 0x69, 0x6e, 0x2d, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x3a, 0x01, 0x2a, 0x12, 0xbe, 0x01,
-	0x0a, 0x0f, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x56, 0x61, 0x72, 0x47, 0x72, 0x6f, 0x75,
-	0x70, 0x12, 0x23, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e,
-	0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x56, 0x61, 0x72, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d,
-	0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x56, 0x61, 0x72, 0x47, 0x72, 0x6f, 0x75,
-	0x70, 0x73, 0x22, 0x6a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x64, 0x12, 0x15, 0x2f, 0x70, 0x6c, 0x61,
-	0x63, 0x65, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x2d, 0x76, 0x61, 0x72, 0x2d, 0x67, 0x72, 0x6f, 0x75,
-	0x70, 0x5a, 0x1a, 0x22, 0x15, 0x2f, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x2f, 0x73, 0x74, 0x61, 0x74,
-	0x2d, 0x76, 0x61, 0x72, 0x2d, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x3a, 0x01, 0x2a, 0x5a, 0x15, 0x12,
-	0x13, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x2d, 0x76, 0x61, 0x72, 0x2f, 0x67, 0x72, 0x6f, 0x75, 0x70,
-	0x2f, 0x61, 0x6c, 0x6c, 0x5a, 0x18, 0x22, 0x13, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x2d, 0x76, 0x61,
-	0x72, 0x2f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2f, 0x61, 0x6c, 0x6c, 0x3a, 0x01, 0x2a, 0x12, 0x8c,
-	0x01, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x56, 0x61, 0x72, 0x47, 0x72, 0x6f,
-	0x75, 0x70, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x27, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d,
-	0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x56, 0x61, 0x72, 0x47,
-	0x72, 0x6f, 0x75, 0x70, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x1d, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x53, 0x74,
-	0x61, 0x74, 0x56, 0x61, 0x72, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4e, 0x6f, 0x64, 0x65, 0x22, 0x2d,
-	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x27, 0x12, 0x0f, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x2d, 0x76, 0x61,
-	0x72, 0x2f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5a, 0x14, 0x22, 0x0f, 0x2f, 0x73, 0x74, 0x61, 0x74,
-	0x2d, 0x76, 0x61, 0x72, 0x2f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x3a, 0x01, 0x2a, 0x12, 0x86, 0x01,
-	0x0a, 0x0e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x56, 0x61, 0x72, 0x50, 0x61, 0x74, 0x68,
-	0x12, 0x22, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x47,
-	0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x56, 0x61, 0x72, 0x50, 0x61, 0x74, 0x68, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f,
-	0x6e, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x56, 0x61, 0x72, 0x50, 0x61, 0x74,
-	0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2b, 0x82, 0xd3, 0xe4, 0x93, 0x02,
-	0x25, 0x12, 0x0e, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x2d, 0x76, 0x61, 0x72, 0x2f, 0x70, 0x61, 0x74,
-	0x68, 0x5a, 0x13, 0x22, 0x0e, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x2d, 0x76, 0x61, 0x72, 0x2f, 0x70,
-	0x61, 0x74, 0x68, 0x3a, 0x01, 0x2a, 0x12, 0x87, 0x01, 0x0a, 0x0d, 0x53, 0x65, 0x61, 0x72, 0x63,
-	0x68, 0x53, 0x74, 0x61, 0x74, 0x56, 0x61, 0x72, 0x12, 0x21, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63,
-	0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x53, 0x74, 0x61,
-	0x74, 0x56, 0x61, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x64, 0x61,
-	0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68,
-	0x53, 0x74, 0x61, 0x74, 0x56, 0x61, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x2f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x29, 0x12, 0x10, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x2d, 0x76,
-	0x61, 0x72, 0x2f, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5a, 0x15, 0x22, 0x10, 0x2f, 0x73, 0x74,
-	0x61, 0x74, 0x2d, 0x76, 0x61, 0x72, 0x2f, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x3a, 0x01, 0x2a,
-	0x42, 0x09, 0x5a, 0x07, 0x2e, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x06, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x33,
+	0xab, 0x01, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74,
+	0x56, 0x61, 0x72, 0x73, 0x55, 0x6e, 0x69, 0x6f, 0x6e, 0x12, 0x29, 0x2e, 0x64, 0x61, 0x74, 0x61,
+	0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x63, 0x65,
+	0x53, 0x74, 0x61, 0x74, 0x56, 0x61, 0x72, 0x73, 0x55, 0x6e, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f,
+	0x6e, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x56,
+	0x61, 0x72, 0x73, 0x55, 0x6e, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x3b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x35, 0x12, 0x16, 0x2f, 0x70, 0x6c, 0x61, 0x63, 0x65,
+	0x2f, 0x73, 0x74, 0x61, 0x74, 0x2d, 0x76, 0x61, 0x72, 0x73, 0x2f, 0x75, 0x6e, 0x69, 0x6f, 0x6e,
+	0x5a, 0x1b, 0x22, 0x16, 0x2f, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x2d,
+	0x76, 0x61, 0x72, 0x73, 0x2f, 0x75, 0x6e, 0x69, 0x6f, 0x6e, 0x3a, 0x01, 0x2a, 0x12, 0xcb, 0x01,
+	0x0a, 0x1b, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x44, 0x61,
+	0x74, 0x65, 0x57, 0x69, 0x74, 0x68, 0x69, 0x6e, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x12, 0x2f, 0x2e,
+	0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x50,
+	0x6c, 0x61, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x44, 0x61, 0x74, 0x65, 0x57, 0x69, 0x74, 0x68,
+	0x69, 0x6e, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x30,
+	0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x47, 0x65, 0x74,
+	0x50, 0x6c, 0x61, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x44, 0x61, 0x74, 0x65, 0x57, 0x69, 0x74,
+	0x68, 0x69, 0x6e, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x49, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x43, 0x12, 0x1d, 0x2f, 0x70, 0x6c, 0x61, 0x63, 0x65,
+	0x2f, 0x73, 0x74, 0x61, 0x74, 0x2f, 0x64, 0x61, 0x74, 0x65, 0x2f, 0x77, 0x69, 0x74, 0x68, 0x69,
+	0x6e, 0x2d, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x5a, 0x22, 0x22, 0x1d, 0x2f, 0x70, 0x6c, 0x61, 0x63,
+	0x65, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x2f, 0x64, 0x61, 0x74, 0x65, 0x2f, 0x77, 0x69, 0x74, 0x68,
+	0x69, 0x6e, 0x2d, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x3a, 0x01, 0x2a, 0x12, 0xbe, 0x01, 0x0a, 0x0f,
+	0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x56, 0x61, 0x72, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12,
+	0x23, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x47, 0x65,
+	0x74, 0x53, 0x74, 0x61, 0x74, 0x56, 0x61, 0x72, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f,
+	0x6e, 0x73, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x56, 0x61, 0x72, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73,
+	0x22, 0x6a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x64, 0x12, 0x15, 0x2f, 0x70, 0x6c, 0x61, 0x63, 0x65,
+	0x2f, 0x73, 0x74, 0x61, 0x74, 0x2d, 0x76, 0x61, 0x72, 0x2d, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5a,
+	0x1a, 0x22, 0x15, 0x2f, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x2d, 0x76,
+	0x61, 0x72, 0x2d, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x3a, 0x01, 0x2a, 0x5a, 0x15, 0x12, 0x13, 0x2f,
+	0x73, 0x74, 0x61, 0x74, 0x2d, 0x76, 0x61, 0x72, 0x2f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x2f, 0x61,
+	0x6c, 0x6c, 0x5a, 0x18, 0x22, 0x13, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x2d, 0x76, 0x61, 0x72, 0x2f,
+	0x67, 0x72, 0x6f, 0x75, 0x70, 0x2f, 0x61, 0x6c, 0x6c, 0x3a, 0x01, 0x2a, 0x12, 0x8c, 0x01, 0x0a,
+	0x13, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x56, 0x61, 0x72, 0x47, 0x72, 0x6f, 0x75, 0x70,
+	0x4e, 0x6f, 0x64, 0x65, 0x12, 0x27, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f,
+	0x6e, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x56, 0x61, 0x72, 0x47, 0x72, 0x6f,
+	0x75, 0x70, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e,
+	0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x53, 0x74, 0x61, 0x74,
+	0x56, 0x61, 0x72, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4e, 0x6f, 0x64, 0x65, 0x22, 0x2d, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x27, 0x12, 0x0f, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x2d, 0x76, 0x61, 0x72, 0x2f,
+	0x67, 0x72, 0x6f, 0x75, 0x70, 0x5a, 0x14, 0x22, 0x0f, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x2d, 0x76,
+	0x61, 0x72, 0x2f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x3a, 0x01, 0x2a, 0x12, 0x86, 0x01, 0x0a, 0x0e,
+	0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x56, 0x61, 0x72, 0x50, 0x61, 0x74, 0x68, 0x12, 0x22,
+	0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x47, 0x65, 0x74,
+	0x53, 0x74, 0x61, 0x74, 0x56, 0x61, 0x72, 0x50, 0x61, 0x74, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x23, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73,
+	0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x56, 0x61, 0x72, 0x50, 0x61, 0x74, 0x68, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x25, 0x12,
+	0x0e, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x2d, 0x76, 0x61, 0x72, 0x2f, 0x70, 0x61, 0x74, 0x68, 0x5a,
+	0x13, 0x22, 0x0e, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x2d, 0x76, 0x61, 0x72, 0x2f, 0x70, 0x61, 0x74,
+	0x68, 0x3a, 0x01, 0x2a, 0x12, 0x87, 0x01, 0x0a, 0x0d, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x53,
+	0x74, 0x61, 0x74, 0x56, 0x61, 0x72, 0x12, 0x21, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x63, 0x6f, 0x6d,
+	0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x53, 0x74, 0x61, 0x74, 0x56,
+	0x61, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x64, 0x61, 0x74, 0x61,
+	0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x73, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x53, 0x74,
+	0x61, 0x74, 0x56, 0x61, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2f, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x29, 0x12, 0x10, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x2d, 0x76, 0x61, 0x72,
+	0x2f, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5a, 0x15, 0x22, 0x10, 0x2f, 0x73, 0x74, 0x61, 0x74,
+	0x2d, 0x76, 0x61, 0x72, 0x2f, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x3a, 0x01, 0x2a, 0x42, 0x09,
+	0x5a, 0x07, 0x2e, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x33,
 }
 
 var (
@@ -4739,191 +5184,199 @@ func file_mixer_proto_rawDescGZIP() []byte {
 	return file_mixer_proto_rawDescData
 }
 
-var file_mixer_proto_msgTypes = make([]protoimpl.MessageInfo, 71)
+var file_mixer_proto_msgTypes = make([]protoimpl.MessageInfo, 73)
 var file_mixer_proto_goTypes = []interface{}{
 	(*QueryRequest)(nil),                        // 0: datacommons.QueryRequest
 	(*QueryResponseCell)(nil),                   // 1: datacommons.QueryResponseCell
-	(*QueryResponseRow)(nil),                    // 2: datacommons.QueryResponseRow
-	(*QueryResponse)(nil),                       // 3: datacommons.QueryResponse
-	(*TranslateRequest)(nil),                    // 4: datacommons.TranslateRequest
-	(*TranslateResponse)(nil),                   // 5: datacommons.TranslateResponse
-	(*GetPropertyLabelsRequest)(nil),            // 6: datacommons.GetPropertyLabelsRequest
-	(*GetPropertyLabelsResponse)(nil),           // 7: datacommons.GetPropertyLabelsResponse
-	(*GetPropertyValuesRequest)(nil),            // 8: datacommons.GetPropertyValuesRequest
-	(*GetPropertyValuesResponse)(nil),           // 9: datacommons.GetPropertyValuesResponse
-	(*GetTriplesRequest)(nil),                   // 10: datacommons.GetTriplesRequest
-	(*GetTriplesResponse)(nil),                  // 11: datacommons.GetTriplesResponse
-	(*PropertyValue)(nil),                       // 12: datacommons.PropertyValue
-	(*GetPlaceObsRequest)(nil),                  // 13: datacommons.GetPlaceObsRequest
-	(*GetPlacesInRequest)(nil),                  // 14: datacommons.GetPlacesInRequest
-	(*GetPlacesInResponse)(nil),                 // 15: datacommons.GetPlacesInResponse
-	(*GetRelatedLocationsRequest)(nil),          // 16: datacommons.GetRelatedLocationsRequest
-	(*GetLocationsRankingsRequest)(nil),         // 17: datacommons.GetLocationsRankingsRequest
-	(*GetLocationsRankingsResponse)(nil),        // 18: datacommons.GetLocationsRankingsResponse
-	(*GetRelatedLocationsResponse)(nil),         // 19: datacommons.GetRelatedLocationsResponse
-	(*Place)(nil),                               // 20: datacommons.Place
-	(*Places)(nil),                              // 21: datacommons.Places
-	(*GetLandingPageDataRequest)(nil),           // 22: datacommons.GetLandingPageDataRequest
-	(*GetLandingPageDataResponse)(nil),          // 23: datacommons.GetLandingPageDataResponse
-	(*SearchRequest)(nil),                       // 24: datacommons.SearchRequest
-	(*SearchResponse)(nil),                      // 25: datacommons.SearchResponse
-	(*GetVersionRequest)(nil),                   // 26: datacommons.GetVersionRequest
-	(*GetVersionResponse)(nil),                  // 27: datacommons.GetVersionResponse
-	(*SearchResultSection)(nil),                 // 28: datacommons.SearchResultSection
-	(*SearchEntityResult)(nil),                  // 29: datacommons.SearchEntityResult
-	(*StatsVars)(nil),                           // 30: datacommons.StatsVars
-	(*GetPlaceStatsVarRequest)(nil),             // 31: datacommons.GetPlaceStatsVarRequest
-	(*GetPlaceStatsVarResponse)(nil),            // 32: datacommons.GetPlaceStatsVarResponse
-	(*StatVars)(nil),                            // 33: datacommons.StatVars
-	(*GetPlaceStatVarsRequest)(nil),             // 34: datacommons.GetPlaceStatVarsRequest
-	(*GetPlaceStatVarsResponse)(nil),            // 35: datacommons.GetPlaceStatVarsResponse
-	(*GetPlaceStatVarsUnionRequest)(nil),        // 36: datacommons.GetPlaceStatVarsUnionRequest
-	(*GetPlaceStatVarsUnionResponse)(nil),       // 37: datacommons.GetPlaceStatVarsUnionResponse
-	(*GetPlaceStatVarsUnionResponseV1)(nil),     // 38: datacommons.GetPlaceStatVarsUnionResponseV1
-	(*StatVarGroups)(nil),                       // 39: datacommons.StatVarGroups
-	(*StatVarGroupNode)(nil),                    // 40: datacommons.StatVarGroupNode
-	(*GetStatVarGroupRequest)(nil),              // 41: datacommons.GetStatVarGroupRequest
-	(*GetStatVarGroupNodeRequest)(nil),          // 42: datacommons.GetStatVarGroupNodeRequest
-	(*SVOPlace)(nil),                            // 43: datacommons.SVOPlace
-	(*SVOObservation)(nil),                      // 44: datacommons.SVOObservation
-	(*SVOCollection)(nil),                       // 45: datacommons.SVOCollection
-	(*EntityInfo)(nil),                          // 46: datacommons.EntityInfo
-	(*EntityInfoCollection)(nil),                // 47: datacommons.EntityInfoCollection
-	(*ContainedInPlaceRelation)(nil),            // 48: datacommons.ContainedInPlaceRelation
-	(*Triple)(nil),                              // 49: datacommons.Triple
-	(*Triples)(nil),                             // 50: datacommons.Triples
-	(*ProvenanceInfo)(nil),                      // 51: datacommons.ProvenanceInfo
-	(*Provenances)(nil),                         // 52: datacommons.Provenances
-	(*PropertyLabels)(nil),                      // 53: datacommons.PropertyLabels
-	(*RelatedPlacesInfo)(nil),                   // 54: datacommons.RelatedPlacesInfo
-	(*GetStatVarPathRequest)(nil),               // 55: datacommons.GetStatVarPathRequest
-	(*GetStatVarPathResponse)(nil),              // 56: datacommons.GetStatVarPathResponse
-	(*SearchStatVarRequest)(nil),                // 57: datacommons.SearchStatVarRequest
-	(*SearchStatVarResponse)(nil),               // 58: datacommons.SearchStatVarResponse
-	nil,                                         // 59: datacommons.GetLocationsRankingsResponse.PayloadEntry
-	nil,                                         // 60: datacommons.GetLandingPageDataResponse.StatVarSeriesEntry
-	nil,                                         // 61: datacommons.GetLandingPageDataResponse.AllChildPlacesEntry
-	nil,                                         // 62: datacommons.GetPlaceStatsVarResponse.PlacesEntry
-	nil,                                         // 63: datacommons.GetPlaceStatVarsResponse.PlacesEntry
-	nil,                                         // 64: datacommons.StatVarGroups.StatVarGroupsEntry
-	(*StatVarGroupNode_ChildSVG)(nil),           // 65: datacommons.StatVarGroupNode.ChildSVG
-	(*StatVarGroupNode_ChildSV)(nil),            // 66: datacommons.StatVarGroupNode.ChildSV
-	(*SVOPlace_Temp)(nil),                       // 67: datacommons.SVOPlace.Temp
-	(*SVOObservation_Temp)(nil),                 // 68: datacommons.SVOObservation.Temp
-	(*RelatedPlacesInfo_Ranking)(nil),           // 69: datacommons.RelatedPlacesInfo.Ranking
-	(*RelatedPlacesInfo_Ranking_RankInfo)(nil),  // 70: datacommons.RelatedPlacesInfo.Ranking.RankInfo
-	(*StatVarSeries)(nil),                       // 71: datacommons.StatVarSeries
-	(*GetStatsRequest)(nil),                     // 72: datacommons.GetStatsRequest
-	(*GetStatSetSeriesRequest)(nil),             // 73: datacommons.GetStatSetSeriesRequest
-	(*GetStatValueRequest)(nil),                 // 74: datacommons.GetStatValueRequest
-	(*GetStatSeriesRequest)(nil),                // 75: datacommons.GetStatSeriesRequest
-	(*GetStatAllRequest)(nil),                   // 76: datacommons.GetStatAllRequest
-	(*GetStatSetWithinPlaceRequest)(nil),        // 77: datacommons.GetStatSetWithinPlaceRequest
-	(*GetStatSetRequest)(nil),                   // 78: datacommons.GetStatSetRequest
-	(*GetPlaceStatDateWithinPlaceRequest)(nil),  // 79: datacommons.GetPlaceStatDateWithinPlaceRequest
-	(*GetStatsResponse)(nil),                    // 80: datacommons.GetStatsResponse
-	(*GetStatSetSeriesResponse)(nil),            // 81: datacommons.GetStatSetSeriesResponse
-	(*GetStatValueResponse)(nil),                // 82: datacommons.GetStatValueResponse
-	(*GetStatSeriesResponse)(nil),               // 83: datacommons.GetStatSeriesResponse
-	(*GetStatAllResponse)(nil),                  // 84: datacommons.GetStatAllResponse
-	(*GetStatSetResponse)(nil),                  // 85: datacommons.GetStatSetResponse
-	(*GetPlaceStatDateWithinPlaceResponse)(nil), // 86: datacommons.GetPlaceStatDateWithinPlaceResponse
+	(*QueryResponseCellList)(nil),               // 2: datacommons.QueryResponseCellList
+	(*QueryResponseRow)(nil),                    // 3: datacommons.QueryResponseRow
+	(*QueryResponse)(nil),                       // 4: datacommons.QueryResponse
+	(*QueryStreamResponse)(nil),                 // 5: datacommons.QueryStreamResponse
+	(*TranslateRequest)(nil),                    // 6: datacommons.TranslateRequest
+	(*TranslateResponse)(nil),                   // 7: datacommons.TranslateResponse
+	(*GetPropertyLabelsRequest)(nil),            // 8: datacommons.GetPropertyLabelsRequest
+	(*GetPropertyLabelsResponse)(nil),           // 9: datacommons.GetPropertyLabelsResponse
+	(*GetPropertyValuesRequest)(nil),            // 10: datacommons.GetPropertyValuesRequest
+	(*GetPropertyValuesResponse)(nil),           // 11: datacommons.GetPropertyValuesResponse
+	(*GetTriplesRequest)(nil),                   // 12: datacommons.GetTriplesRequest
+	(*GetTriplesResponse)(nil),                  // 13: datacommons.GetTriplesResponse
+	(*PropertyValue)(nil),                       // 14: datacommons.PropertyValue
+	(*GetPlaceObsRequest)(nil),                  // 15: datacommons.GetPlaceObsRequest
+	(*GetPlacesInRequest)(nil),                  // 16: datacommons.GetPlacesInRequest
+	(*GetPlacesInResponse)(nil),                 // 17: datacommons.GetPlacesInResponse
+	(*GetRelatedLocationsRequest)(nil),          // 18: datacommons.GetRelatedLocationsRequest
+	(*GetLocationsRankingsRequest)(nil),         // 19: datacommons.GetLocationsRankingsRequest
+	(*GetLocationsRankingsResponse)(nil),        // 20: datacommons.GetLocationsRankingsResponse
+	(*GetRelatedLocationsResponse)(nil),         // 21: datacommons.GetRelatedLocationsResponse
+	(*Place)(nil),                               // 22: datacommons.Place
+	(*Places)(nil),                              // 23: datacommons.Places
+	(*GetLandingPageDataRequest)(nil),           // 24: datacommons.GetLandingPageDataRequest
+	(*GetLandingPageDataResponse)(nil),          // 25: datacommons.GetLandingPageDataResponse
+	(*SearchRequest)(nil),                       // 26: datacommons.SearchRequest
+	(*SearchResponse)(nil),                      // 27: datacommons.SearchResponse
+	(*GetVersionRequest)(nil),                   // 28: datacommons.GetVersionRequest
+	(*GetVersionResponse)(nil),                  // 29: datacommons.GetVersionResponse
+	(*SearchResultSection)(nil),                 // 30: datacommons.SearchResultSection
+	(*SearchEntityResult)(nil),                  // 31: datacommons.SearchEntityResult
+	(*StatsVars)(nil),                           // 32: datacommons.StatsVars
+	(*GetPlaceStatsVarRequest)(nil),             // 33: datacommons.GetPlaceStatsVarRequest
+	(*GetPlaceStatsVarResponse)(nil),            // 34: datacommons.GetPlaceStatsVarResponse
+	(*StatVars)(nil),                            // 35: datacommons.StatVars
+	(*GetPlaceStatVarsRequest)(nil),             // 36: datacommons.GetPlaceStatVarsRequest
+	(*GetPlaceStatVarsResponse)(nil),            // 37: datacommons.GetPlaceStatVarsResponse
+	(*GetPlaceStatVarsUnionRequest)(nil),        // 38: datacommons.GetPlaceStatVarsUnionRequest
+	(*GetPlaceStatVarsUnionResponse)(nil),       // 39: datacommons.GetPlaceStatVarsUnionResponse
+	(*GetPlaceStatVarsUnionResponseV1)(nil),     // 40: datacommons.GetPlaceStatVarsUnionResponseV1
+	(*StatVarGroups)(nil),                       // 41: datacommons.StatVarGroups
+	(*StatVarGroupNode)(nil),                    // 42: datacommons.StatVarGroupNode
+	(*GetStatVarGroupRequest)(nil),              // 43: datacommons.GetStatVarGroupRequest
+	(*GetStatVarGroupNodeRequest)(nil),          // 44: datacommons.GetStatVarGroupNodeRequest
+	(*SVOPlace)(nil),                            // 45: datacommons.SVOPlace
+	(*SVOObservation)(nil),                      // 46: datacommons.SVOObservation
+	(*SVOCollection)(nil),                       // 47: datacommons.SVOCollection
+	(*EntityInfo)(nil),                          // 48: datacommons.EntityInfo
+	(*EntityInfoCollection)(nil),                // 49: datacommons.EntityInfoCollection
+	(*ContainedInPlaceRelation)(nil),            // 50: datacommons.ContainedInPlaceRelation
+	(*Triple)(nil),                              // 51: datacommons.Triple
+	(*Triples)(nil),                             // 52: datacommons.Triples
+	(*ProvenanceInfo)(nil),                      // 53: datacommons.ProvenanceInfo
+	(*Provenances)(nil),                         // 54: datacommons.Provenances
+	(*PropertyLabels)(nil),                      // 55: datacommons.PropertyLabels
+	(*RelatedPlacesInfo)(nil),                   // 56: datacommons.RelatedPlacesInfo
+	(*GetStatVarPathRequest)(nil),               // 57: datacommons.GetStatVarPathRequest
+	(*GetStatVarPathResponse)(nil),              // 58: datacommons.GetStatVarPathResponse
+	(*SearchStatVarRequest)(nil),                // 59: datacommons.SearchStatVarRequest
+	(*SearchStatVarResponse)(nil),               // 60: datacommons.SearchStatVarResponse
+	nil,                                         // 61: datacommons.GetLocationsRankingsResponse.PayloadEntry
+	nil,                                         // 62: datacommons.GetLandingPageDataResponse.StatVarSeriesEntry
+	nil,                                         // 63: datacommons.GetLandingPageDataResponse.AllChildPlacesEntry
+	nil,                                         // 64: datacommons.GetPlaceStatsVarResponse.PlacesEntry
+	nil,                                         // 65: datacommons.GetPlaceStatVarsResponse.PlacesEntry
+	nil,                                         // 66: datacommons.StatVarGroups.StatVarGroupsEntry
+	(*StatVarGroupNode_ChildSVG)(nil),           // 67: datacommons.StatVarGroupNode.ChildSVG
+	(*StatVarGroupNode_ChildSV)(nil),            // 68: datacommons.StatVarGroupNode.ChildSV
+	(*SVOPlace_Temp)(nil),                       // 69: datacommons.SVOPlace.Temp
+	(*SVOObservation_Temp)(nil),                 // 70: datacommons.SVOObservation.Temp
+	(*RelatedPlacesInfo_Ranking)(nil),           // 71: datacommons.RelatedPlacesInfo.Ranking
+	(*RelatedPlacesInfo_Ranking_RankInfo)(nil),  // 72: datacommons.RelatedPlacesInfo.Ranking.RankInfo
+	(*StatVarSeries)(nil),                       // 73: datacommons.StatVarSeries
+	(*GetStatsRequest)(nil),                     // 74: datacommons.GetStatsRequest
+	(*GetStatSetSeriesRequest)(nil),             // 75: datacommons.GetStatSetSeriesRequest
+	(*GetStatValueRequest)(nil),                 // 76: datacommons.GetStatValueRequest
+	(*GetStatSeriesRequest)(nil),                // 77: datacommons.GetStatSeriesRequest
+	(*GetStatAllRequest)(nil),                   // 78: datacommons.GetStatAllRequest
+	(*GetStatSetWithinPlaceRequest)(nil),        // 79: datacommons.GetStatSetWithinPlaceRequest
+	(*GetStatSetRequest)(nil),                   // 80: datacommons.GetStatSetRequest
+	(*GetPlaceStatDateWithinPlaceRequest)(nil),  // 81: datacommons.GetPlaceStatDateWithinPlaceRequest
+	(*GetStatsResponse)(nil),                    // 82: datacommons.GetStatsResponse
+	(*GetStatSetSeriesResponse)(nil),            // 83: datacommons.GetStatSetSeriesResponse
+	(*GetStatValueResponse)(nil),                // 84: datacommons.GetStatValueResponse
+	(*GetStatSeriesResponse)(nil),               // 85: datacommons.GetStatSeriesResponse
+	(*GetStatAllResponse)(nil),                  // 86: datacommons.GetStatAllResponse
+	(*GetStatSetResponse)(nil),                  // 87: datacommons.GetStatSetResponse
+	(*GetPlaceStatDateWithinPlaceResponse)(nil), // 88: datacommons.GetPlaceStatDateWithinPlaceResponse
 }
 var file_mixer_proto_depIdxs = []int32{
-	1,  // 0: datacommons.QueryResponseRow.cells:type_name -> datacommons.QueryResponseCell
-	2,  // 1: datacommons.QueryResponse.rows:type_name -> datacommons.QueryResponseRow
-	59, // 2: datacommons.GetLocationsRankingsResponse.payload:type_name -> datacommons.GetLocationsRankingsResponse.PayloadEntry
-	20, // 3: datacommons.Places.places:type_name -> datacommons.Place
-	60, // 4: datacommons.GetLandingPageDataResponse.stat_var_series:type_name -> datacommons.GetLandingPageDataResponse.StatVarSeriesEntry
-	61, // 5: datacommons.GetLandingPageDataResponse.all_child_places:type_name -> datacommons.GetLandingPageDataResponse.AllChildPlacesEntry
-	28, // 6: datacommons.SearchResponse.section:type_name -> datacommons.SearchResultSection
-	29, // 7: datacommons.SearchResultSection.entity:type_name -> datacommons.SearchEntityResult
-	62, // 8: datacommons.GetPlaceStatsVarResponse.places:type_name -> datacommons.GetPlaceStatsVarResponse.PlacesEntry
-	63, // 9: datacommons.GetPlaceStatVarsResponse.places:type_name -> datacommons.GetPlaceStatVarsResponse.PlacesEntry
-	33, // 10: datacommons.GetPlaceStatVarsUnionResponse.stat_vars:type_name -> datacommons.StatVars
-	64, // 11: datacommons.StatVarGroups.stat_var_groups:type_name -> datacommons.StatVarGroups.StatVarGroupsEntry
-	66, // 12: datacommons.StatVarGroupNode.child_stat_vars:type_name -> datacommons.StatVarGroupNode.ChildSV
-	65, // 13: datacommons.StatVarGroupNode.child_stat_var_groups:type_name -> datacommons.StatVarGroupNode.ChildSVG
-	44, // 14: datacommons.SVOPlace.observations:type_name -> datacommons.SVOObservation
-	67, // 15: datacommons.SVOPlace.temp:type_name -> datacommons.SVOPlace.Temp
-	68, // 16: datacommons.SVOObservation.temp:type_name -> datacommons.SVOObservation.Temp
-	43, // 17: datacommons.SVOCollection.places:type_name -> datacommons.SVOPlace
-	46, // 18: datacommons.EntityInfoCollection.entities:type_name -> datacommons.EntityInfo
-	49, // 19: datacommons.Triples.triples:type_name -> datacommons.Triple
-	51, // 20: datacommons.Provenances.provenances:type_name -> datacommons.ProvenanceInfo
-	69, // 21: datacommons.RelatedPlacesInfo.rank_all:type_name -> datacommons.RelatedPlacesInfo.Ranking
-	69, // 22: datacommons.RelatedPlacesInfo.rank_top_1000:type_name -> datacommons.RelatedPlacesInfo.Ranking
-	69, // 23: datacommons.RelatedPlacesInfo.rank_bottom_1000:type_name -> datacommons.RelatedPlacesInfo.Ranking
-	46, // 24: datacommons.SearchStatVarResponse.stat_vars:type_name -> datacommons.EntityInfo
-	46, // 25: datacommons.SearchStatVarResponse.stat_var_groups:type_name -> datacommons.EntityInfo
-	54, // 26: datacommons.GetLocationsRankingsResponse.PayloadEntry.value:type_name -> datacommons.RelatedPlacesInfo
-	71, // 27: datacommons.GetLandingPageDataResponse.StatVarSeriesEntry.value:type_name -> datacommons.StatVarSeries
-	21, // 28: datacommons.GetLandingPageDataResponse.AllChildPlacesEntry.value:type_name -> datacommons.Places
-	30, // 29: datacommons.GetPlaceStatsVarResponse.PlacesEntry.value:type_name -> datacommons.StatsVars
-	33, // 30: datacommons.GetPlaceStatVarsResponse.PlacesEntry.value:type_name -> datacommons.StatVars
-	40, // 31: datacommons.StatVarGroups.StatVarGroupsEntry.value:type_name -> datacommons.StatVarGroupNode
-	70, // 32: datacommons.RelatedPlacesInfo.Ranking.info:type_name -> datacommons.RelatedPlacesInfo.Ranking.RankInfo
-	0,  // 33: datacommons.Mixer.Query:input_type -> datacommons.QueryRequest
-	6,  // 34: datacommons.Mixer.GetPropertyLabels:input_type -> datacommons.GetPropertyLabelsRequest
-	8,  // 35: datacommons.Mixer.GetPropertyValues:input_type -> datacommons.GetPropertyValuesRequest
-	10, // 36: datacommons.Mixer.GetTriples:input_type -> datacommons.GetTriplesRequest
-	14, // 37: datacommons.Mixer.GetPlacesIn:input_type -> datacommons.GetPlacesInRequest
-	13, // 38: datacommons.Mixer.GetPlaceObs:input_type -> datacommons.GetPlaceObsRequest
-	72, // 39: datacommons.Mixer.GetStats:input_type -> datacommons.GetStatsRequest
-	73, // 40: datacommons.Mixer.GetStatSetSeries:input_type -> datacommons.GetStatSetSeriesRequest
-	74, // 41: datacommons.Mixer.GetStatValue:input_type -> datacommons.GetStatValueRequest
-	75, // 42: datacommons.Mixer.GetStatSeries:input_type -> datacommons.GetStatSeriesRequest
-	76, // 43: datacommons.Mixer.GetStatAll:input_type -> datacommons.GetStatAllRequest
-	77, // 44: datacommons.Mixer.GetStatSetWithinPlace:input_type -> datacommons.GetStatSetWithinPlaceRequest
-	78, // 45: datacommons.Mixer.GetStatSet:input_type -> datacommons.GetStatSetRequest
-	17, // 46: datacommons.Mixer.GetLocationsRankings:input_type -> datacommons.GetLocationsRankingsRequest
-	16, // 47: datacommons.Mixer.GetRelatedLocations:input_type -> datacommons.GetRelatedLocationsRequest
-	22, // 48: datacommons.Mixer.GetLandingPageData:input_type -> datacommons.GetLandingPageDataRequest
-	4,  // 49: datacommons.Mixer.Translate:input_type -> datacommons.TranslateRequest
-	24, // 50: datacommons.Mixer.Search:input_type -> datacommons.SearchRequest
-	26, // 51: datacommons.Mixer.GetVersion:input_type -> datacommons.GetVersionRequest
-	31, // 52: datacommons.Mixer.GetPlaceStatsVar:input_type -> datacommons.GetPlaceStatsVarRequest
-	34, // 53: datacommons.Mixer.GetPlaceStatVars:input_type -> datacommons.GetPlaceStatVarsRequest
-	36, // 54: datacommons.Mixer.GetPlaceStatVarsUnionV1:input_type -> datacommons.GetPlaceStatVarsUnionRequest
-	36, // 55: datacommons.Mixer.GetPlaceStatVarsUnion:input_type -> datacommons.GetPlaceStatVarsUnionRequest
-	79, // 56: datacommons.Mixer.GetPlaceStatDateWithinPlace:input_type -> datacommons.GetPlaceStatDateWithinPlaceRequest
-	41, // 57: datacommons.Mixer.GetStatVarGroup:input_type -> datacommons.GetStatVarGroupRequest
-	42, // 58: datacommons.Mixer.GetStatVarGroupNode:input_type -> datacommons.GetStatVarGroupNodeRequest
-	55, // 59: datacommons.Mixer.GetStatVarPath:input_type -> datacommons.GetStatVarPathRequest
-	57, // 60: datacommons.Mixer.SearchStatVar:input_type -> datacommons.SearchStatVarRequest
-	3,  // 61: datacommons.Mixer.Query:output_type -> datacommons.QueryResponse
-	7,  // 62: datacommons.Mixer.GetPropertyLabels:output_type -> datacommons.GetPropertyLabelsResponse
-	9,  // 63: datacommons.Mixer.GetPropertyValues:output_type -> datacommons.GetPropertyValuesResponse
-	11, // 64: datacommons.Mixer.GetTriples:output_type -> datacommons.GetTriplesResponse
-	15, // 65: datacommons.Mixer.GetPlacesIn:output_type -> datacommons.GetPlacesInResponse
-	45, // 66: datacommons.Mixer.GetPlaceObs:output_type -> datacommons.SVOCollection
-	80, // 67: datacommons.Mixer.GetStats:output_type -> datacommons.GetStatsResponse
-	81, // 68: datacommons.Mixer.GetStatSetSeries:output_type -> datacommons.GetStatSetSeriesResponse
-	82, // 69: datacommons.Mixer.GetStatValue:output_type -> datacommons.GetStatValueResponse
-	83, // 70: datacommons.Mixer.GetStatSeries:output_type -> datacommons.GetStatSeriesResponse
-	84, // 71: datacommons.Mixer.GetStatAll:output_type -> datacommons.GetStatAllResponse
-	85, // 72: datacommons.Mixer.GetStatSetWithinPlace:output_type -> datacommons.GetStatSetResponse
-	85, // 73: datacommons.Mixer.GetStatSet:output_type -> datacommons.GetStatSetResponse
-	18, // 74: datacommons.Mixer.GetLocationsRankings:output_type -> datacommons.GetLocationsRankingsResponse
-	19, // 75: datacommons.Mixer.GetRelatedLocations:output_type -> datacommons.GetRelatedLocationsResponse
-	23, // 76: datacommons.Mixer.GetLandingPageData:output_type -> datacommons.GetLandingPageDataResponse
-	5,  // 77: datacommons.Mixer.Translate:output_type -> datacommons.TranslateResponse
-	25, // 78: datacommons.Mixer.Search:output_type -> datacommons.SearchResponse
-	27, // 79: datacommons.Mixer.GetVersion:output_type -> datacommons.GetVersionResponse
-	32, // 80: datacommons.Mixer.GetPlaceStatsVar:output_type -> datacommons.GetPlaceStatsVarResponse
-	35, // 81: datacommons.Mixer.GetPlaceStatVars:output_type -> datacommons.GetPlaceStatVarsResponse
-	38, // 82: datacommons.Mixer.GetPlaceStatVarsUnionV1:output_type -> datacommons.GetPlaceStatVarsUnionResponseV1
-	37, // 83: datacommons.Mixer.GetPlaceStatVarsUnion:output_type -> datacommons.GetPlaceStatVarsUnionResponse
-	86, // 84: datacommons.Mixer.GetPlaceStatDateWithinPlace:output_type -> datacommons.GetPlaceStatDateWithinPlaceResponse
-	39, // 85: datacommons.Mixer.GetStatVarGroup:output_type -> datacommons.StatVarGroups
-	40, // 86: datacommons.Mixer.GetStatVarGroupNode:output_type -> datacommons.StatVarGroupNode
-	56, // 87: datacommons.Mixer.GetStatVarPath:output_type -> datacommons.GetStatVarPathResponse
-	58, // 88: datacommons.Mixer.SearchStatVar:output_type -> datacommons.SearchStatVarResponse
-	61, // [61:89] is the sub-list for method output_type
-	33, // [33:61] is the sub-list for method input_type
-	33, // [33:33] is the sub-list for extension type_name
-	33, // [33:33] is the sub-list for extension extendee
-	0,  // [0:33] is the sub-list for field type_name
+	2,  // 0: datacommons.QueryResponseCell.list_value:type_name -> datacommons.QueryResponseCellList
+	1,  // 1: datacommons.QueryResponseCellList.cells:type_name -> datacommons.QueryResponseCell
+	1,  // 2: datacommons.QueryResponseRow.cells:type_name -> datacommons.QueryResponseCell
+	3,  // 3: datacommons.QueryResponse.rows:type_name -> datacommons.QueryResponseRow
+	51, // 4: datacommons.QueryResponse.triples:type_name -> datacommons.Triple
+	3,  // 5: datacommons.QueryStreamResponse.rows:type_name -> datacommons.QueryResponseRow
+	61, // 6: datacommons.GetLocationsRankingsResponse.payload:type_name -> datacommons.GetLocationsRankingsResponse.PayloadEntry
+	22, // 7: datacommons.Places.places:type_name -> datacommons.Place
+	62, // 8: datacommons.GetLandingPageDataResponse.stat_var_series:type_name -> datacommons.GetLandingPageDataResponse.StatVarSeriesEntry
+	63, // 9: datacommons.GetLandingPageDataResponse.all_child_places:type_name -> datacommons.GetLandingPageDataResponse.AllChildPlacesEntry
+	30, // 10: datacommons.SearchResponse.section:type_name -> datacommons.SearchResultSection
+	31, // 11: datacommons.SearchResultSection.entity:type_name -> datacommons.SearchEntityResult
+	64, // 12: datacommons.GetPlaceStatsVarResponse.places:type_name -> datacommons.GetPlaceStatsVarResponse.PlacesEntry
+	65, // 13: datacommons.GetPlaceStatVarsResponse.places:type_name -> datacommons.GetPlaceStatVarsResponse.PlacesEntry
+	35, // 14: datacommons.GetPlaceStatVarsUnionResponse.stat_vars:type_name -> datacommons.StatVars
+	66, // 15: datacommons.StatVarGroups.stat_var_groups:type_name -> datacommons.StatVarGroups.StatVarGroupsEntry
+	68, // 16: datacommons.StatVarGroupNode.child_stat_vars:type_name -> datacommons.StatVarGroupNode.ChildSV
+	67, // 17: datacommons.StatVarGroupNode.child_stat_var_groups:type_name -> datacommons.StatVarGroupNode.ChildSVG
+	46, // 18: datacommons.SVOPlace.observations:type_name -> datacommons.SVOObservation
+	69, // 19: datacommons.SVOPlace.temp:type_name -> datacommons.SVOPlace.Temp
+	70, // 20: datacommons.SVOObservation.temp:type_name -> datacommons.SVOObservation.Temp
+	45, // 21: datacommons.SVOCollection.places:type_name -> datacommons.SVOPlace
+	48, // 22: datacommons.EntityInfoCollection.entities:type_name -> datacommons.EntityInfo
+	51, // 23: datacommons.Triples.triples:type_name -> datacommons.Triple
+	53, // 24: datacommons.Provenances.provenances:type_name -> datacommons.ProvenanceInfo
+	71, // 25: datacommons.RelatedPlacesInfo.rank_all:type_name -> datacommons.RelatedPlacesInfo.Ranking
+	71, // 26: datacommons.RelatedPlacesInfo.rank_top_1000:type_name -> datacommons.RelatedPlacesInfo.Ranking
+	71, // 27: datacommons.RelatedPlacesInfo.rank_bottom_1000:type_name -> datacommons.RelatedPlacesInfo.Ranking
+	48, // 28: datacommons.SearchStatVarResponse.stat_vars:type_name -> datacommons.EntityInfo
+	48, // 29: datacommons.SearchStatVarResponse.stat_var_groups:type_name -> datacommons.EntityInfo
+	56, // 30: datacommons.GetLocationsRankingsResponse.PayloadEntry.value:type_name -> datacommons.RelatedPlacesInfo
+	73, // 31: datacommons.GetLandingPageDataResponse.StatVarSeriesEntry.value:type_name -> datacommons.StatVarSeries
+	23, // 32: datacommons.GetLandingPageDataResponse.AllChildPlacesEntry.value:type_name -> datacommons.Places
+	32, // 33: datacommons.GetPlaceStatsVarResponse.PlacesEntry.value:type_name -> datacommons.StatsVars
+	35, // 34: datacommons.GetPlaceStatVarsResponse.PlacesEntry.value:type_name -> datacommons.StatVars
+	42, // 35: datacommons.StatVarGroups.StatVarGroupsEntry.value:type_name -> datacommons.StatVarGroupNode
+	72, // 36: datacommons.RelatedPlacesInfo.Ranking.info:type_name -> datacommons.RelatedPlacesInfo.Ranking.RankInfo
+	0,  // 37: datacommons.Mixer.Query:input_type -> datacommons.QueryRequest
+	0,  // 38: datacommons.Mixer.QueryStream:input_type -> datacommons.QueryRequest
+	8,  // 39: datacommons.Mixer.GetPropertyLabels:input_type -> datacommons.GetPropertyLabelsRequest
+	10, // 40: datacommons.Mixer.GetPropertyValues:input_type -> datacommons.GetPropertyValuesRequest
+	12, // 41: datacommons.Mixer.GetTriples:input_type -> datacommons.GetTriplesRequest
+	16, // 42: datacommons.Mixer.GetPlacesIn:input_type -> datacommons.GetPlacesInRequest
+	15, // 43: datacommons.Mixer.GetPlaceObs:input_type -> datacommons.GetPlaceObsRequest
+	74, // 44: datacommons.Mixer.GetStats:input_type -> datacommons.GetStatsRequest
+	75, // 45: datacommons.Mixer.GetStatSetSeries:input_type -> datacommons.GetStatSetSeriesRequest
+	76, // 46: datacommons.Mixer.GetStatValue:input_type -> datacommons.GetStatValueRequest
+	77, // 47: datacommons.Mixer.GetStatSeries:input_type -> datacommons.GetStatSeriesRequest
+	78, // 48: datacommons.Mixer.GetStatAll:input_type -> datacommons.GetStatAllRequest
+	79, // 49: datacommons.Mixer.GetStatSetWithinPlace:input_type -> datacommons.GetStatSetWithinPlaceRequest
+	80, // 50: datacommons.Mixer.GetStatSet:input_type -> datacommons.GetStatSetRequest
+	19, // 51: datacommons.Mixer.GetLocationsRankings:input_type -> datacommons.GetLocationsRankingsRequest
+	18, // 52: datacommons.Mixer.GetRelatedLocations:input_type -> datacommons.GetRelatedLocationsRequest
+	24, // 53: datacommons.Mixer.GetLandingPageData:input_type -> datacommons.GetLandingPageDataRequest
+	6,  // 54: datacommons.Mixer.Translate:input_type -> datacommons.TranslateRequest
+	26, // 55: datacommons.Mixer.Search:input_type -> datacommons.SearchRequest
+	28, // 56: datacommons.Mixer.GetVersion:input_type -> datacommons.GetVersionRequest
+	33, // 57: datacommons.Mixer.GetPlaceStatsVar:input_type -> datacommons.GetPlaceStatsVarRequest
+	36, // 58: datacommons.Mixer.GetPlaceStatVars:input_type -> datacommons.GetPlaceStatVarsRequest
+	38, // 59: datacommons.Mixer.GetPlaceStatVarsUnionV1:input_type -> datacommons.GetPlaceStatVarsUnionRequest
+	38, // 60: datacommons.Mixer.GetPlaceStatVarsUnion:input_type -> datacommons.GetPlaceStatVarsUnionRequest
+	81, // 61: datacommons.Mixer.GetPlaceStatDateWithinPlace:input_type -> datacommons.GetPlaceStatDateWithinPlaceRequest
+	43, // 62: datacommons.Mixer.GetStatVarGroup:input_type -> datacommons.GetStatVarGroupRequest
+	44, // 63: datacommons.Mixer.GetStatVarGroupNode:input_type -> datacommons.GetStatVarGroupNodeRequest
+	57, // 64: datacommons.Mixer.GetStatVarPath:input_type -> datacommons.GetStatVarPathRequest
+	59, // 65: datacommons.Mixer.SearchStatVar:input_type -> datacommons.SearchStatVarRequest
+	4,  // 66: datacommons.Mixer.Query:output_type -> datacommons.QueryResponse
+	5,  // 67: datacommons.Mixer.QueryStream:output_type -> datacommons.QueryStreamResponse
+	9,  // 68: datacommons.Mixer.GetPropertyLabels:output_type -> datacommons.GetPropertyLabelsResponse
+	11, // 69: datacommons.Mixer.GetPropertyValues:output_type -> datacommons.GetPropertyValuesResponse
+	13, // 70: datacommons.Mixer.GetTriples:output_type -> datacommons.GetTriplesResponse
+	17, // 71: datacommons.Mixer.GetPlacesIn:output_type -> datacommons.GetPlacesInResponse
+	47, // 72: datacommons.Mixer.GetPlaceObs:output_type -> datacommons.SVOCollection
+	82, // 73: datacommons.Mixer.GetStats:output_type -> datacommons.GetStatsResponse
+	83, // 74: datacommons.Mixer.GetStatSetSeries:output_type -> datacommons.GetStatSetSeriesResponse
+	84, // 75: datacommons.Mixer.GetStatValue:output_type -> datacommons.GetStatValueResponse
+	85, // 76: datacommons.Mixer.GetStatSeries:output_type -> datacommons.GetStatSeriesResponse
+	86, // 77: datacommons.Mixer.GetStatAll:output_type -> datacommons.GetStatAllResponse
+	87, // 78: datacommons.Mixer.GetStatSetWithinPlace:output_type -> datacommons.GetStatSetResponse
+	87, // 79: datacommons.Mixer.GetStatSet:output_type -> datacommons.GetStatSetResponse
+	20, // 80: datacommons.Mixer.GetLocationsRankings:output_type -> datacommons.GetLocationsRankingsResponse
+	21, // 81: datacommons.Mixer.GetRelatedLocations:output_type -> datacommons.GetRelatedLocationsResponse
+	25, // 82: datacommons.Mixer.GetLandingPageData:output_type -> datacommons.GetLandingPageDataResponse
+	7,  // 83: datacommons.Mixer.Translate:output_type -> datacommons.TranslateResponse
+	27, // 84: datacommons.Mixer.Search:output_type -> datacommons.SearchResponse
+	29, // 85: datacommons.Mixer.GetVersion:output_type -> datacommons.GetVersionResponse
+	34, // 86: datacommons.Mixer.GetPlaceStatsVar:output_type -> datacommons.GetPlaceStatsVarResponse
+	37, // 87: datacommons.Mixer.GetPlaceStatVars:output_type -> datacommons.GetPlaceStatVarsResponse
+	40, // 88: datacommons.Mixer.GetPlaceStatVarsUnionV1:output_type -> datacommons.GetPlaceStatVarsUnionResponseV1
+	39, // 89: datacommons.Mixer.GetPlaceStatVarsUnion:output_type -> datacommons.GetPlaceStatVarsUnionResponse
+	88, // 90: datacommons.Mixer.GetPlaceStatDateWithinPlace:output_type -> datacommons.GetPlaceStatDateWithinPlaceResponse
+	41, // 91: datacommons.Mixer.GetStatVarGroup:output_type -> datacommons.StatVarGroups
+	42, // 92: datacommons.Mixer.GetStatVarGroupNode:output_type -> datacommons.StatVarGroupNode
+	58, // 93: datacommons.Mixer.GetStatVarPath:output_type -> datacommons.GetStatVarPathResponse
+	60, // 94: datacommons.Mixer.SearchStatVar:output_type -> datacommons.SearchStatVarResponse
+	66, // [66:95] is the sub-list for method output_type
+	37, // [37:66] is the sub-list for method input_type
+	37, // [37:37] is the sub-list for extension type_name
+	37, // [37:37] is the sub-list for extension extendee
+	0,  // [0:37] is the sub-list for field type_name
 }
 
 func init() { file_mixer_proto_init() }
@@ -4958,7 +5411,7 @@ func file_mixer_proto_init() {
 			}
 		}
 		file_mixer_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryResponseRow); i {
+			switch v := v.(*QueryResponseCellList); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4970,7 +5423,7 @@ func file_mixer_proto_init() {
 			}
 		}
 		file_mixer_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryResponse); i {
+			switch v := v.(*QueryResponseRow); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4982,7 +5435,7 @@ func file_mixer_proto_init() {
 			}
 		}
 		file_mixer_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TranslateRequest); i {
+			switch v := v.(*QueryResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4994,7 +5447,7 @@ func file_mixer_proto_init() {
 			}
 		}
 		file_mixer_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TranslateResponse); i {
+			switch v := v.(*QueryStreamResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5006,7 +5459,7 @@ func file_mixer_proto_init() {
 			}
 		}
 		file_mixer_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetPropertyLabelsRequest); i {
+			switch v := v.(*TranslateRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5018,7 +5471,7 @@ func file_mixer_proto_init() {
 			}
 		}
 		file_mixer_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetPropertyLabelsResponse); i {
+			switch v := v.(*TranslateResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5030,7 +5483,7 @@ func file_mixer_proto_init() {
 			}
 		}
 		file_mixer_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetPropertyValuesRequest); i {
+			switch v := v.(*GetPropertyLabelsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5042,7 +5495,7 @@ func file_mixer_proto_init() {
 			}
 		}
 		file_mixer_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetPropertyValuesResponse); i {
+			switch v := v.(*GetPropertyLabelsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5054,7 +5507,7 @@ func file_mixer_proto_init() {
 			}
 		}
 		file_mixer_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetTriplesRequest); i {
+			switch v := v.(*GetPropertyValuesRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5066,7 +5519,7 @@ func file_mixer_proto_init() {
 			}
 		}
 		file_mixer_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetTriplesResponse); i {
+			switch v := v.(*GetPropertyValuesResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5078,7 +5531,7 @@ func file_mixer_proto_init() {
 			}
 		}
 		file_mixer_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PropertyValue); i {
+			switch v := v.(*GetTriplesRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5090,7 +5543,7 @@ func file_mixer_proto_init() {
 			}
 		}
 		file_mixer_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetPlaceObsRequest); i {
+			switch v := v.(*GetTriplesResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5102,7 +5555,7 @@ func file_mixer_proto_init() {
 			}
 		}
 		file_mixer_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetPlacesInRequest); i {
+			switch v := v.(*PropertyValue); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5114,7 +5567,7 @@ func file_mixer_proto_init() {
 			}
 		}
 		file_mixer_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetPlacesInResponse); i {
+			switch v := v.(*GetPlaceObsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5126,7 +5579,7 @@ func file_mixer_proto_init() {
 			}
 		}
 		file_mixer_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetRelatedLocationsRequest); i {
+			switch v := v.(*GetPlacesInRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5138,7 +5591,7 @@ func file_mixer_proto_init() {
 			}
 		}
 		file_mixer_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetLocationsRankingsRequest); i {
+			switch v := v.(*GetPlacesInResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5150,7 +5603,7 @@ func file_mixer_proto_init() {
 			}
 		}
 		file_mixer_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetLocationsRankingsResponse); i {
+			switch v := v.(*GetRelatedLocationsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5162,7 +5615,7 @@ func file_mixer_proto_init() {
 			}
 		}
 		file_mixer_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetRelatedLocationsResponse); i {
+			switch v := v.(*GetLocationsRankingsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5174,7 +5627,7 @@ func file_mixer_proto_init() {
 			}
 		}
 		file_mixer_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Place); i {
+			switch v := v.(*GetLocationsRankingsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5186,7 +5639,7 @@ func file_mixer_proto_init() {
 			}
 		}
 		file_mixer_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Places); i {
+			switch v := v.(*GetRelatedLocationsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5198,7 +5651,7 @@ func file_mixer_proto_init() {
 			}
 		}
 		file_mixer_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetLandingPageDataRequest); i {
+			switch v := v.(*Place); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5210,7 +5663,7 @@ func file_mixer_proto_init() {
 			}
 		}
 		file_mixer_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetLandingPageDataResponse); i {
+			switch v := v.(*Places); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5222,7 +5675,7 @@ func file_mixer_proto_init() {
 			}
 		}
 		file_mixer_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SearchRequest); i {
+			switch v := v.(*GetLandingPageDataRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5234,7 +5687,7 @@ func file_mixer_proto_init() {
 			}
 		}
 		file_mixer_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SearchResponse); i {
+			switch v := v.(*GetLandingPageDataResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5246,7 +5699,7 @@ func file_mixer_proto_init() {
 			}
 		}
 		file_mixer_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetVersionRequest); i {
+			switch v := v.(*SearchRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5258,7 +5711,7 @@ func file_mixer_proto_init() {
 			}
 		}
 		file_mixer_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetVersionResponse); i {
+			switch v := v.(*SearchResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5270,7 +5723,7 @@ func file_mixer_proto_init() {
 			}
 		}
 		file_mixer_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SearchResultSection); i {
+			switch v := v.(*GetVersionRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5282,7 +5735,7 @@ func file_mixer_proto_init() {
 			}
 		}
 		file_mixer_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SearchEntityResult); i {
+			switch v := v.(*GetVersionResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5294,7 +5747,7 @@ func file_mixer_proto_init() {
 			}
 		}
 		file_mixer_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StatsVars); i {
+			switch v := v.(*SearchResultSection); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5306,7 +5759,7 @@ func file_mixer_proto_init() {
 			}
 		}
 		file_mixer_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetPlaceStatsVarRequest); i {
+			switch v := v.(*SearchEntityResult); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5318,7 +5771,7 @@ func file_mixer_proto_init() {
 			}
 		}
 		file_mixer_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetPlaceStatsVarResponse); i {
+			switch v := v.(*StatsVars); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5330,7 +5783,7 @@ func file_mixer_proto_init() {
 			}
 		}
 		file_mixer_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StatVars); i {
+			switch v := v.(*GetPlaceStatsVarRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5342,7 +5795,7 @@ func file_mixer_proto_init() {
 			}
 		}
 		file_mixer_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetPlaceStatVarsRequest); i {
+			switch v := v.(*GetPlaceStatsVarResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5354,7 +5807,7 @@ func file_mixer_proto_init() {
 			}
 		}
 		file_mixer_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetPlaceStatVarsResponse); i {
+			switch v := v.(*StatVars); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5366,7 +5819,7 @@ func file_mixer_proto_init() {
 			}
 		}
 		file_mixer_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetPlaceStatVarsUnionRequest); i {
+			switch v := v.(*GetPlaceStatVarsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5378,7 +5831,7 @@ func file_mixer_proto_init() {
 			}
 		}
 		file_mixer_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetPlaceStatVarsUnionResponse); i {
+			switch v := v.(*GetPlaceStatVarsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5390,7 +5843,7 @@ func file_mixer_proto_init() {
 			}
 		}
 		file_mixer_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetPlaceStatVarsUnionResponseV1); i {
+			switch v := v.(*GetPlaceStatVarsUnionRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5402,7 +5855,7 @@ func file_mixer_proto_init() {
 			}
 		}
 		file_mixer_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StatVarGroups); i {
+			switch v := v.(*GetPlaceStatVarsUnionResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5414,7 +5867,7 @@ func file_mixer_proto_init() {
 			}
 		}
 		file_mixer_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StatVarGroupNode); i {
+			switch v := v.(*GetPlaceStatVarsUnionResponseV1); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5426,7 +5879,7 @@ func file_mixer_proto_init() {
 			}
 		}
 		file_mixer_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetStatVarGroupRequest); i {
+			switch v := v.(*StatVarGroups); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5438,7 +5891,7 @@ func file_mixer_proto_init() {
 			}
 		}
 		file_mixer_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetStatVarGroupNodeRequest); i {
+			switch v := v.(*StatVarGroupNode); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5450,7 +5903,7 @@ func file_mixer_proto_init() {
 			}
 		}
 		file_mixer_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SVOPlace); i {
+			switch v := v.(*GetStatVarGroupRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5462,7 +5915,7 @@ func file_mixer_proto_init() {
 			}
 		}
 		file_mixer_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SVOObservation); i {
+			switch v := v.(*GetStatVarGroupNodeRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5474,7 +5927,7 @@ func file_mixer_proto_init() {
 			}
 		}
 		file_mixer_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SVOCollection); i {
+			switch v := v.(*SVOPlace); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5486,7 +5939,7 @@ func file_mixer_proto_init() {
 			}
 		}
 		file_mixer_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EntityInfo); i {
+			switch v := v.(*SVOObservation); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5498,7 +5951,7 @@ func file_mixer_proto_init() {
 			}
 		}
 		file_mixer_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EntityInfoCollection); i {
+			switch v := v.(*SVOCollection); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5510,7 +5963,7 @@ func file_mixer_proto_init() {
 			}
 		}
 		file_mixer_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ContainedInPlaceRelation); i {
+			switch v := v.(*EntityInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5522,7 +5975,7 @@ func file_mixer_proto_init() {
 			}
 		}
 		file_mixer_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Triple); i {
+			switch v := v.(*EntityInfoCollection); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5534,7 +5987,7 @@ func file_mixer_proto_init() {
 			}
 		}
 		file_mixer_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Triples); i {
+			switch v := v.(*ContainedInPlaceRelation); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5546,7 +5999,7 @@ func file_mixer_proto_init() {
 			}
 		}
 		file_mixer_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProvenanceInfo); i {
+			switch v := v.(*Triple); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5558,7 +6011,7 @@ func file_mixer_proto_init() {
 			}
 		}
 		file_mixer_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Provenances); i {
+			switch v := v.(*Triples); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5570,7 +6023,7 @@ func file_mixer_proto_init() {
 			}
 		}
 		file_mixer_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PropertyLabels); i {
+			switch v := v.(*ProvenanceInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5582,7 +6035,7 @@ func file_mixer_proto_init() {
 			}
 		}
 		file_mixer_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RelatedPlacesInfo); i {
+			switch v := v.(*Provenances); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5594,7 +6047,7 @@ func file_mixer_proto_init() {
 			}
 		}
 		file_mixer_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetStatVarPathRequest); i {
+			switch v := v.(*PropertyLabels); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5606,7 +6059,7 @@ func file_mixer_proto_init() {
 			}
 		}
 		file_mixer_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetStatVarPathResponse); i {
+			switch v := v.(*RelatedPlacesInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5618,7 +6071,7 @@ func file_mixer_proto_init() {
 			}
 		}
 		file_mixer_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SearchStatVarRequest); i {
+			switch v := v.(*GetStatVarPathRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5630,6 +6083,30 @@ func file_mixer_proto_init() {
 			}
 		}
 		file_mixer_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetStatVarPathResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mixer_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchStatVarRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mixer_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SearchStatVarResponse); i {
 			case 0:
 				return &v.state
@@ -5641,7 +6118,7 @@ func file_mixer_proto_init() {
 				return nil
 			}
 		}
-		file_mixer_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+		file_mixer_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*StatVarGroupNode_ChildSVG); i {
 			case 0:
 				return &v.state
@@ -5653,7 +6130,7 @@ func file_mixer_proto_init() {
 				return nil
 			}
 		}
-		file_mixer_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+		file_mixer_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*StatVarGroupNode_ChildSV); i {
 			case 0:
 				return &v.state
@@ -5665,7 +6142,7 @@ func file_mixer_proto_init() {
 				return nil
 			}
 		}
-		file_mixer_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
+		file_mixer_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SVOPlace_Temp); i {
 			case 0:
 				return &v.state
@@ -5677,7 +6154,7 @@ func file_mixer_proto_init() {
 				return nil
 			}
 		}
-		file_mixer_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
+		file_mixer_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SVOObservation_Temp); i {
 			case 0:
 				return &v.state
@@ -5689,7 +6166,7 @@ func file_mixer_proto_init() {
 				return nil
 			}
 		}
-		file_mixer_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
+		file_mixer_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*RelatedPlacesInfo_Ranking); i {
 			case 0:
 				return &v.state
@@ -5701,7 +6178,7 @@ func file_mixer_proto_init() {
 				return nil
 			}
 		}
-		file_mixer_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
+		file_mixer_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*RelatedPlacesInfo_Ranking_RankInfo); i {
 			case 0:
 				return &v.state
@@ -5714,7 +6191,15 @@ func file_mixer_proto_init() {
 			}
 		}
 	}
-	file_mixer_proto_msgTypes[44].OneofWrappers = []interface{}{
+	file_mixer_proto_msgTypes[1].OneofWrappers = []interface{}{
+		(*QueryResponseCell_IntValue)(nil),
+		(*QueryResponseCell_DoubleValue)(nil),
+		(*QueryResponseCell_BoolValue)(nil),
+		(*QueryResponseCell_DateValue)(nil),
+		(*QueryResponseCell_StringValue)(nil),
+		(*QueryResponseCell_ListValue)(nil),
+	}
+	file_mixer_proto_msgTypes[46].OneofWrappers = []interface{}{
 		(*SVOObservation_StrValue)(nil),
 		(*SVOObservation_DblValue)(nil),
 	}
@@ -5724,7 +6209,7 @@ func file_mixer_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_mixer_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   71,
+			NumMessages:   73,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
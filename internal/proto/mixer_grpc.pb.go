@@ -19,6 +19,9 @@ const _ = grpc.SupportPackageIsVersion6
 type MixerClient interface {
 	// Query DataCommons Graph with Sparql.
 	Query(ctx context.Context, in *QueryRequest, opts ...grpc.CallOption) (*QueryResponse, error)
+	// Query DataCommons Graph with Sparql, streaming the result rows in batches.
+	// Pagination, result formats and dry run are not supported.
+	QueryStream(ctx context.Context, in *QueryRequest, opts ...grpc.CallOption) (Mixer_QueryStreamClient, error)
 	// Fetch property labels adjacent of nodes
 	GetPropertyLabels(ctx context.Context, in *GetPropertyLabelsRequest, opts ...grpc.CallOption) (*GetPropertyLabelsResponse, error)
 	// Fetch nodes that linked to source nodes with a given property.
@@ -112,6 +115,38 @@ func (c *mixerClient) Query(ctx context.Context, in *QueryRequest, opts ...grpc.
 	return out, nil
 }
 
+func (c *mixerClient) QueryStream(ctx context.Context, in *QueryRequest, opts ...grpc.CallOption) (Mixer_QueryStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Mixer_serviceDesc.Streams[0], "/datacommons.Mixer/QueryStream", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &mixerQueryStreamClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Mixer_QueryStreamClient interface {
+	Recv() (*QueryStreamResponse, error)
+	grpc.ClientStream
+}
+
+type mixerQueryStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *mixerQueryStreamClient) Recv() (*QueryStreamResponse, error) {
+	m := new(QueryStreamResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *mixerClient) GetPropertyLabels(ctx context.Context, in *GetPropertyLabelsRequest, opts ...grpc.CallOption) (*GetPropertyLabelsResponse, error) {
 	out := new(GetPropertyLabelsResponse)
 	err := c.cc.Invoke(ctx, "/datacommons.Mixer/GetPropertyLabels", in, out, opts...)
@@ -361,6 +396,9 @@ func (c *mixerClient) SearchStatVar(ctx context.Context, in *SearchStatVarReques
 type MixerServer interface {
 	// Query DataCommons Graph with Sparql.
 	Query(context.Context, *QueryRequest) (*QueryResponse, error)
+	// Query DataCommons Graph with Sparql, streaming the result rows in batches.
+	// Pagination, result formats and dry run are not supported.
+	QueryStream(*QueryRequest, Mixer_QueryStreamServer) error
 	// Fetch property labels adjacent of nodes
 	GetPropertyLabels(context.Context, *GetPropertyLabelsRequest) (*GetPropertyLabelsResponse, error)
 	// Fetch nodes that linked to source nodes with a given property.
@@ -444,6 +482,9 @@ type UnimplementedMixerServer struct {
 func (*UnimplementedMixerServer) Query(context.Context, *QueryRequest) (*QueryResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Query not implemented")
 }
+func (*UnimplementedMixerServer) QueryStream(*QueryRequest, Mixer_QueryStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method QueryStream not implemented")
+}
 func (*UnimplementedMixerServer) GetPropertyLabels(context.Context, *GetPropertyLabelsRequest) (*GetPropertyLabelsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetPropertyLabels not implemented")
 }
@@ -548,6 +589,27 @@ func _Mixer_Query_Handler(srv interface{}, ctx context.Context, dec func(interfa
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Mixer_QueryStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(QueryRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(MixerServer).QueryStream(m, &mixerQueryStreamServer{stream})
+}
+
+type Mixer_QueryStreamServer interface {
+	Send(*QueryStreamResponse) error
+	grpc.ServerStream
+}
+
+type mixerQueryStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *mixerQueryStreamServer) Send(m *QueryStreamResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _Mixer_GetPropertyLabels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetPropertyLabelsRequest)
 	if err := dec(in); err != nil {
@@ -1151,6 +1213,12 @@ var _Mixer_serviceDesc = grpc.ServiceDesc{
 			Handler:    _Mixer_SearchStatVar_Handler,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "QueryStream",
+			Handler:       _Mixer_QueryStream_Handler,
+			ServerStreams: true,
+		},
+	},
 	Metadata: "mixer.proto",
 }
@@ -0,0 +1,130 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+
+	pb "github.com/datacommonsorg/mixer/internal/proto"
+	"github.com/datacommonsorg/mixer/internal/store"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *Server) branchCaches() *pb.BranchCaches {
+	result := &pb.BranchCaches{}
+	for _, l := range s.store.BranchLayers() {
+		branchCache := &pb.BranchCache{
+			Name:  l.Name,
+			Table: l.Table,
+		}
+		if l.Previous != nil {
+			branchCache.PreviousTable = l.Previous.Table
+		}
+		result.BranchCaches = append(result.BranchCaches, branchCache)
+	}
+	return result
+}
+
+// ListBranchCaches implements API for MixerAdmin.ListBranchCaches.
+func (s *Server) ListBranchCaches(
+	ctx context.Context, in *pb.ListBranchCachesRequest) (*pb.BranchCaches, error) {
+	return s.branchCaches(), nil
+}
+
+// AddBranchCache implements API for MixerAdmin.AddBranchCache.
+func (s *Server) AddBranchCache(
+	ctx context.Context, in *pb.AddBranchCacheRequest) (*pb.BranchCaches, error) {
+	name := in.GetBranchCache().GetName()
+	table := in.GetBranchCache().GetTable()
+	if name == "" || table == "" {
+		return nil, status.Errorf(
+			codes.InvalidArgument, "Missing required arguments: name, table")
+	}
+	reader, err := s.openBranchTable(ctx, table)
+	if err != nil {
+		return nil, err
+	}
+	return s.addBranchTable(ctx, name, table, reader)
+}
+
+func (s *Server) addBranchTable(
+	ctx context.Context, name, table string, reader store.KVReader) (*pb.BranchCaches, error) {
+	if err := s.checkBranchTable(ctx, table, reader); err != nil {
+		return nil, err
+	}
+	if err := s.store.AddBranchLayer(name, table, reader); err != nil {
+		return nil, err
+	}
+	return s.branchCaches(), nil
+}
+
+// UpdateBranchCache implements API for MixerAdmin.UpdateBranchCache.
+func (s *Server) UpdateBranchCache(
+	ctx context.Context, in *pb.UpdateBranchCacheRequest) (*pb.BranchCaches, error) {
+	name := in.GetBranchCache().GetName()
+	table := in.GetBranchCache().GetTable()
+	if name == "" || table == "" {
+		return nil, status.Errorf(
+			codes.InvalidArgument, "Missing required arguments: name, table")
+	}
+	reader, err := s.openBranchTable(ctx, table)
+	if err != nil {
+		return nil, err
+	}
+	return s.updateBranchTable(ctx, name, table, reader)
+}
+
+func (s *Server) updateBranchTable(
+	ctx context.Context, name, table string, reader store.KVReader) (*pb.BranchCaches, error) {
+	if err := s.checkBranchTable(ctx, table, reader); err != nil {
+		return nil, err
+	}
+	if err := s.store.ReplaceBranchLayer(name, table, reader); err != nil {
+		return nil, err
+	}
+	return s.branchCaches(), nil
+}
+
+// RemoveBranchCache implements API for MixerAdmin.RemoveBranchCache.
+func (s *Server) RemoveBranchCache(
+	ctx context.Context, in *pb.RemoveBranchCacheRequest) (*pb.BranchCaches, error) {
+	if err := s.store.RemoveBranchLayer(in.GetName()); err != nil {
+		return nil, err
+	}
+	return s.branchCaches(), nil
+}
+
+// ReorderBranchCaches implements API for MixerAdmin.ReorderBranchCaches.
+func (s *Server) ReorderBranchCaches(
+	ctx context.Context, in *pb.ReorderBranchCachesRequest) (*pb.BranchCaches, error) {
+	if err := s.store.ReorderBranchLayers(in.GetNames()); err != nil {
+		return nil, err
+	}
+	return s.branchCaches(), nil
+}
+
+// RollbackBranchCache implements API for MixerAdmin.RollbackBranchCache.
+func (s *Server) RollbackBranchCache(
+	ctx context.Context, in *pb.RollbackBranchCacheRequest) (*pb.BranchCaches, error) {
+	name := in.GetName()
+	if name == "" {
+		name = store.DefaultBranchLayer
+	}
+	if err := s.store.RollbackBranchLayer(name); err != nil {
+		return nil, err
+	}
+	return s.branchCaches(), nil
+}
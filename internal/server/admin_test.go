@@ -0,0 +1,264 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"testing"
+
+	pb "github.com/datacommonsorg/mixer/internal/proto"
+	"github.com/datacommonsorg/mixer/internal/store"
+	"github.com/datacommonsorg/mixer/internal/util"
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/testing/protocmp"
+)
+
+func TestBranchCacheAdmin(t *testing.T) {
+	ctx := context.Background()
+	s := NewServer(nil, nil, nil, nil, nil)
+	for _, name := range []string{"census", "bls"} {
+		if err := s.store.AddBranchLayer(
+			name, name+"_table", store.NewMemoryReader(map[string]string{})); err != nil {
+			t.Fatalf("AddBranchLayer(%s) = %s", name, err)
+		}
+	}
+
+	got, err := s.ReorderBranchCaches(ctx, &pb.ReorderBranchCachesRequest{
+		Names: []string{"bls", "census"},
+	})
+	if err != nil {
+		t.Fatalf("ReorderBranchCaches() = %s", err)
+	}
+	want := &pb.BranchCaches{BranchCaches: []*pb.BranchCache{
+		{Name: "bls", Table: "bls_table"},
+		{Name: "census", Table: "census_table"},
+	}}
+	if diff := cmp.Diff(want, got, protocmp.Transform()); diff != "" {
+		t.Errorf("ReorderBranchCaches() got diff %v", diff)
+	}
+
+	got, err = s.RemoveBranchCache(ctx, &pb.RemoveBranchCacheRequest{Name: "bls"})
+	if err != nil {
+		t.Fatalf("RemoveBranchCache() = %s", err)
+	}
+	want = &pb.BranchCaches{BranchCaches: []*pb.BranchCache{
+		{Name: "census", Table: "census_table"},
+	}}
+	if diff := cmp.Diff(want, got, protocmp.Transform()); diff != "" {
+		t.Errorf("RemoveBranchCache() got diff %v", diff)
+	}
+	got, err = s.ListBranchCaches(ctx, &pb.ListBranchCachesRequest{})
+	if err != nil {
+		t.Fatalf("ListBranchCaches() = %s", err)
+	}
+	if diff := cmp.Diff(want, got, protocmp.Transform()); diff != "" {
+		t.Errorf("ListBranchCaches() got diff %v", diff)
+	}
+
+	_, err = s.AddBranchCache(ctx, &pb.AddBranchCacheRequest{
+		BranchCache: &pb.BranchCache{Name: "bls"},
+	})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("AddBranchCache() = %v, want InvalidArgument", err)
+	}
+	_, err = s.RemoveBranchCache(ctx, &pb.RemoveBranchCacheRequest{Name: "bls"})
+	if status.Code(err) != codes.NotFound {
+		t.Errorf("RemoveBranchCache() = %v, want NotFound", err)
+	}
+}
+
+func TestReadBranchLayers(t *testing.T) {
+	ctx := context.Background()
+	encode := func(data map[string]string) store.KVReader {
+		result := map[string]string{}
+		for k, v := range data {
+			encoded, err := util.ZipAndEncode([]byte(v))
+			if err != nil {
+				t.Fatalf("ZipAndEncode() = %s", err)
+			}
+			result[k] = encoded
+		}
+		return store.NewMemoryReader(result)
+	}
+	st := store.NewStore(nil, nil, nil)
+	st.SetMergePolicy(store.NewMergePolicy().Register("list/", store.UnionLists))
+	st.SetBaseBt(encode(map[string]string{
+		"one/a":  "base",
+		"one/b":  "base",
+		"list/c": "base",
+	}))
+	if err := st.AddBranchLayer("low", "", encode(map[string]string{
+		"one/a":  "low",
+		"one/b":  "low",
+		"list/c": "low",
+	})); err != nil {
+		t.Fatal(err)
+	}
+	if err := st.AddBranchLayer("high", "", encode(map[string]string{
+		"one/a":  "high",
+		"list/c": "high",
+	})); err != nil {
+		t.Fatal(err)
+	}
+	read := func() map[string]interface{} {
+		result, err := bigTableReadRowsMerged(
+			ctx,
+			st,
+			[]string{"one/a", "one/b", "list/c"},
+			func(token string, jsonRaw []byte) (interface{}, error) {
+				return []string{string(jsonRaw)}, nil
+			},
+			func(key string) (string, error) { return key, nil },
+		)
+		if err != nil {
+			t.Fatalf("bigTableReadRowsMerged() = %s", err)
+		}
+		return result
+	}
+	want := map[string]interface{}{
+		"one/a":  []string{"high"},
+		"one/b":  []string{"low"},
+		"list/c": []string{"base", "low", "high"},
+	}
+	if diff := cmp.Diff(want, read()); diff != "" {
+		t.Errorf("read layers got diff %v", diff)
+	}
+
+	if err := st.ReorderBranchLayers([]string{"high", "low"}); err != nil {
+		t.Fatal(err)
+	}
+	want = map[string]interface{}{
+		"one/a":  []string{"low"},
+		"one/b":  []string{"low"},
+		"list/c": []string{"base", "high", "low"},
+	}
+	if diff := cmp.Diff(want, read()); diff != "" {
+		t.Errorf("read reordered layers got diff %v", diff)
+	}
+}
+
+func TestProbeBranchTable(t *testing.T) {
+	ctx := context.Background()
+	encoded, err := util.ZipAndEncode([]byte("{}"))
+	if err != nil {
+		t.Fatalf("ZipAndEncode() = %s", err)
+	}
+	reader := store.NewMemoryReader(map[string]string{
+		"d/1/geoId/06": encoded,
+		"d/1/bad":      "bad",
+	})
+	for _, c := range []struct {
+		keys []string
+		code codes.Code
+	}{
+		{nil, codes.OK},
+		{[]string{"d/1/geoId/06"}, codes.OK},
+		{[]string{"d/1/geoId/06", "d/1/geoId/07"}, codes.FailedPrecondition},
+		{[]string{"d/1/bad"}, codes.FailedPrecondition},
+	} {
+		err := probeBranchTable(ctx, reader, c.keys)
+		if got := status.Code(err); got != c.code {
+			t.Errorf("probeBranchTable(%v) = %v, want %s", c.keys, err, c.code)
+		}
+	}
+}
+
+func TestRollbackBranchCache(t *testing.T) {
+	ctx := context.Background()
+	s := NewServer(nil, nil, nil, &Metadata{}, nil)
+	reader := store.NewMemoryReader(map[string]string{})
+	_, err := s.RollbackBranchCache(ctx, &pb.RollbackBranchCacheRequest{})
+	if status.Code(err) != codes.NotFound {
+		t.Errorf("RollbackBranchCache() = %v, want NotFound", err)
+	}
+	for _, table := range []string{"table_1", "table_2"} {
+		if err := s.promoteBranchTable(ctx, table, reader); err != nil {
+			t.Fatalf("promoteBranchTable(%s) = %s", table, err)
+		}
+	}
+	// A table without the sentinel row is not used.
+	s.metadata.BranchSentinelKeys = []string{"d/1/geoId/06"}
+	if err := s.promoteBranchTable(ctx, "table_3", reader); status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("promoteBranchTable(table_3) = %v, want FailedPrecondition", err)
+	}
+
+	version, err := s.GetVersion(ctx, &pb.GetVersionRequest{})
+	if err != nil {
+		t.Fatalf("GetVersion() = %s", err)
+	}
+	if version.GetBranchTable() != "table_2" || version.GetPreviousBranchTable() != "table_1" {
+		t.Errorf("GetVersion() got branch tables %s, %s, want table_2, table_1",
+			version.GetBranchTable(), version.GetPreviousBranchTable())
+	}
+
+	got, err := s.RollbackBranchCache(ctx, &pb.RollbackBranchCacheRequest{})
+	if err != nil {
+		t.Fatalf("RollbackBranchCache() = %s", err)
+	}
+	want := &pb.BranchCaches{BranchCaches: []*pb.BranchCache{
+		{Name: store.DefaultBranchLayer, Table: "table_1", PreviousTable: "table_2"},
+	}}
+	if diff := cmp.Diff(want, got, protocmp.Transform()); diff != "" {
+		t.Errorf("RollbackBranchCache() got diff %v", diff)
+	}
+}
+
+func TestUpdateBranchCache(t *testing.T) {
+	ctx := context.Background()
+	s := NewServer(nil, nil, nil, &Metadata{}, nil)
+	reader := store.NewMemoryReader(map[string]string{})
+	if _, err := s.addBranchTable(ctx, "census", "table_1", reader); err != nil {
+		t.Fatalf("addBranchTable(table_1) = %s", err)
+	}
+	_, err := s.updateBranchTable(ctx, "bls", "table_2", reader)
+	if status.Code(err) != codes.NotFound {
+		t.Errorf("updateBranchTable(bls) = %v, want NotFound", err)
+	}
+	if _, err := s.updateBranchTable(ctx, "census", "table_2", reader); err != nil {
+		t.Fatalf("updateBranchTable(table_2) = %s", err)
+	}
+	// A table without the sentinel row is not used.
+	s.metadata.BranchSentinelKeys = []string{"d/1/geoId/06"}
+	for _, c := range []struct {
+		name string
+		err  error
+	}{
+		{"addBranchTable", func() error {
+			_, err := s.addBranchTable(ctx, "bls", "table_3", reader)
+			return err
+		}()},
+		{"updateBranchTable", func() error {
+			_, err := s.updateBranchTable(ctx, "census", "table_3", reader)
+			return err
+		}()},
+	} {
+		if status.Code(c.err) != codes.FailedPrecondition {
+			t.Errorf("%s(table_3) = %v, want FailedPrecondition", c.name, c.err)
+		}
+	}
+
+	got, err := s.RollbackBranchCache(ctx, &pb.RollbackBranchCacheRequest{Name: "census"})
+	if err != nil {
+		t.Fatalf("RollbackBranchCache() = %s", err)
+	}
+	want := &pb.BranchCaches{BranchCaches: []*pb.BranchCache{
+		{Name: "census", Table: "table_1", PreviousTable: "table_2"},
+	}}
+	if diff := cmp.Diff(want, got, protocmp.Transform()); diff != "" {
+		t.Errorf("RollbackBranchCache() got diff %v", diff)
+	}
+}
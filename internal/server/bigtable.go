@@ -17,7 +17,6 @@ package server
 import (
 	"context"
 
-	"cloud.google.com/go/bigtable"
 	"github.com/datacommonsorg/mixer/internal/store"
 	"github.com/datacommonsorg/mixer/internal/util"
 	"golang.org/x/sync/errgroup"
@@ -30,38 +29,58 @@ import (
 // generated function.
 func readRowFn(
 	errCtx context.Context,
-	btTable *bigtable.Table,
-	rowSetPart bigtable.RowSet,
+	btTable store.KVReader,
+	rowCache *store.RowCache,
+	version string,
+	keys []string,
 	getToken func(string) (string, error),
 	action func(string, []byte) (interface{}, error),
 	elemChan chan chanData,
 ) func() error {
 	return func() error {
-		if err := btTable.ReadRows(errCtx, rowSetPart,
-			func(btRow bigtable.Row) bool {
-				if len(btRow[util.BtFamily]) == 0 {
-					return true
-				}
-				raw := btRow[util.BtFamily][0].Value
+		if getToken == nil {
+			getToken = util.KeyToDcid
+		}
+		handleRow := func(key string, jsonRaw []byte) bool {
+			token, err := getToken(key)
+			if err != nil {
+				return false
+			}
+			elem, err := action(token, jsonRaw)
+			if err != nil {
+				return false
+			}
+			elemChan <- chanData{token, elem}
+			return true
+		}
 
-				if getToken == nil {
-					getToken = util.KeyToDcid
+		// Rows in the row cache are already decoded.
+		missKeys := keys
+		if rowCache != nil {
+			missKeys = []string{}
+			for _, key := range keys {
+				jsonRaw, ok := rowCache.Get(version, key)
+				if !ok {
+					missKeys = append(missKeys, key)
+					continue
 				}
-				token, err := getToken(btRow.Key())
-				if err != nil {
-					return false
+				if !handleRow(key, jsonRaw) {
+					return nil
 				}
+			}
+			if len(missKeys) == 0 {
+				return nil
+			}
+		}
 
+		if err := btTable.MultiGet(errCtx, missKeys,
+			func(key string, raw []byte) bool {
 				jsonRaw, err := util.UnzipAndDecode(string(raw))
 				if err != nil {
 					return false
 				}
-				elem, err := action(token, jsonRaw)
-				if err != nil {
-					return false
-				}
-				elemChan <- chanData{token, elem}
-				return true
+				rowCache.Add(version, key, jsonRaw)
+				return handleRow(key, jsonRaw)
 			}); err != nil {
 			return err
 		}
@@ -69,59 +88,47 @@ func readRowFn(
 	}
 }
 
-// bigTableReadRowsParallel reads BigTable rows from base Bigtable and branch
-// Bigtable in parallel.
-//
-// Reading multiple rows is chunked as the size limit for RowSet is 500KB.
+// bigTableReadLayers reads cache rows from the base cache table and, when
+// readBranch is true, all the branch cache layers in parallel. It returns the
+// rows of each table keyed by token, starting with the base table and followed
+// by the branch layers from the lowest to the highest priority.
 //
-//
-// Args:
-// baseBt: The bigtable that holds the base cache
-// branchBt: The bigtable that holds the branch cache.
-// rowSet: BigTable rowSet containing the row keys.
-// action: A callback function that converts the raw bytes into appropriate
-//		go struct based on the cache content.
-// getToken: A function to get back the indexed token (like place dcid) from
-//		bigtable row key.
-//
-func bigTableReadRowsParallel(
+// Reading multiple rows is chunked as the size limit for Bigtable RowSet is
+// 500KB.
+func bigTableReadLayers(
 	ctx context.Context,
-	store *store.Store,
-	rowSet bigtable.RowSet,
+	st *store.Store,
+	keys []string,
 	action func(string, []byte) (interface{}, error),
 	getToken func(string) (string, error),
 	readBranch bool,
-) (
-	map[string]interface{}, map[string]interface{}, error,
-) {
-	baseBt := store.BaseBt()
-	branchBt := store.BranchBt()
-	if baseBt == nil && branchBt == nil {
-		return nil, nil, status.Errorf(
+) ([]map[string]interface{}, error) {
+	baseBt, baseVersion := st.BaseBtVersion()
+	branchLayers := st.BranchLayers()
+	if baseBt == nil && len(branchLayers) == 0 {
+		return nil, status.Errorf(
 			codes.NotFound, "Bigtable instance is not specified")
 	}
+	tables := []store.KVReader{baseBt}
+	versions := []string{baseVersion}
+	if readBranch {
+		for _, l := range branchLayers {
+			tables = append(tables, l.Reader)
+			versions = append(versions, l.Version)
+		}
+	}
+	rowCache := st.RowCache()
 
 	// Function start
-	var rowSetSize int
-	var rowList bigtable.RowList
-	var rowRangeList bigtable.RowRangeList
-	switch v := rowSet.(type) {
-	case bigtable.RowList:
-		rowList = rowSet.(bigtable.RowList)
-		rowSetSize = len(rowList)
-	case bigtable.RowRangeList:
-		rowRangeList = rowSet.(bigtable.RowRangeList)
-		rowSetSize = len(rowRangeList)
-	default:
-		return nil, nil, status.Errorf(
-			codes.Internal, "Unsupported RowSet type: %v", v)
-	}
+	rowSetSize := len(keys)
 	if rowSetSize == 0 {
-		return nil, nil, nil
+		return nil, nil
 	}
 
-	baseChan := make(chan chanData, rowSetSize)
-	branchChan := make(chan chanData, rowSetSize)
+	chans := make([]chan chanData, len(tables))
+	for i := range chans {
+		chans[i] = make(chan chanData, rowSetSize)
+	}
 
 	errs, errCtx := errgroup.WithContext(ctx)
 	for i := 0; i <= rowSetSize/util.BtBatchQuerySize; i++ {
@@ -130,41 +137,95 @@ func bigTableReadRowsParallel(
 		if right > rowSetSize {
 			right = rowSetSize
 		}
-		var rowSetPart bigtable.RowSet
-		if len(rowList) > 0 {
-			rowSetPart = rowList[left:right]
-		} else {
-			rowSetPart = rowRangeList[left:right]
+		if left == right {
+			continue
 		}
+		keysPart := keys[left:right]
 		// Read from all the given tables.
-		if baseBt != nil {
-			errs.Go(readRowFn(errCtx, baseBt, rowSetPart, getToken, action, baseChan))
-		}
-		if readBranch && branchBt != nil {
-			errs.Go(readRowFn(errCtx, branchBt, rowSetPart, getToken, action, branchChan))
+		for j, table := range tables {
+			if table != nil {
+				errs.Go(readRowFn(errCtx, table, rowCache, versions[j],
+					keysPart, getToken, action, chans[j]))
+			}
 		}
 	}
 	err := errs.Wait()
 	if err != nil {
-		return nil, nil, err
+		return nil, err
 	}
-	close(baseChan)
-	close(branchChan)
-
-	baseResult := map[string]interface{}{}
-	if baseBt != nil {
-		for elem := range baseChan {
-			baseResult[elem.token] = elem.data
+	result := make([]map[string]interface{}, len(tables))
+	for i, c := range chans {
+		close(c)
+		result[i] = map[string]interface{}{}
+		for elem := range c {
+			result[i][elem.token] = elem.data
 		}
 	}
+	return result, nil
+}
 
-	branchResult := map[string]interface{}{}
-	if readBranch {
-		if branchBt != nil {
-			for elem := range branchChan {
-				branchResult[elem.token] = elem.data
-			}
-		}
+// mergeLayers merges the rows of cache tables, from the lowest to the highest
+// priority, with the merge policy which is keyed by row key prefix.
+func mergeLayers(
+	policy *store.MergePolicy,
+	keys []string,
+	getToken func(string) (string, error),
+	layers []map[string]interface{},
+) map[string]interface{} {
+	if getToken == nil {
+		getToken = util.KeyToDcid
+	}
+	result := map[string]interface{}{}
+	for _, layer := range layers {
+		result = policy.MergeRows(keys, getToken, result, layer)
+	}
+	return result
+}
+
+// bigTableReadRowsParallel reads cache rows from base cache table and branch
+// cache layers in parallel. The rows of the branch cache layers are merged
+// into one map.
+//
+//
+// Args:
+// store: The store that holds the base cache and the branch cache layers.
+// keys: The row keys, usually a Bigtable RowList.
+// action: A callback function that converts the raw bytes into appropriate
+//		go struct based on the cache content.
+// getToken: A function to get back the indexed token (like place dcid) from
+//		bigtable row key.
+// readBranch: Whether to read the branch cache layers.
+//
+func bigTableReadRowsParallel(
+	ctx context.Context,
+	store *store.Store,
+	keys []string,
+	action func(string, []byte) (interface{}, error),
+	getToken func(string) (string, error),
+	readBranch bool,
+) (
+	map[string]interface{}, map[string]interface{}, error,
+) {
+	layers, err := bigTableReadLayers(ctx, store, keys, action, getToken, readBranch)
+	if err != nil || layers == nil {
+		return nil, nil, err
+	}
+	return layers[0], mergeLayers(store.MergePolicy(), keys, getToken, layers[1:]), nil
+}
+
+// bigTableReadRowsMerged reads cache rows from base cache table and branch
+// cache layers, and merges the rows in all the tables by priority with the
+// merge policy of the store.
+func bigTableReadRowsMerged(
+	ctx context.Context,
+	store *store.Store,
+	keys []string,
+	action func(string, []byte) (interface{}, error),
+	getToken func(string) (string, error),
+) (map[string]interface{}, error) {
+	layers, err := bigTableReadLayers(ctx, store, keys, action, getToken, true /* readBranch */)
+	if err != nil || layers == nil {
+		return nil, err
 	}
-	return baseResult, branchResult, nil
+	return mergeLayers(store.MergePolicy(), keys, getToken, layers), nil
 }
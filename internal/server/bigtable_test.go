@@ -20,6 +20,7 @@ import (
 
 	"cloud.google.com/go/bigtable"
 	"github.com/datacommonsorg/mixer/internal/store"
+	"github.com/datacommonsorg/mixer/internal/util"
 	"github.com/google/go-cmp/cmp"
 )
 
@@ -102,3 +103,83 @@ func TestReadTwoTables(t *testing.T) {
 		}
 	}
 }
+
+func TestReadMemoryTables(t *testing.T) {
+	ctx := context.Background()
+	encode := func(data map[string]string) map[string]string {
+		result := map[string]string{}
+		for k, v := range data {
+			encoded, err := util.ZipAndEncode([]byte(v))
+			if err != nil {
+				t.Fatalf("ZipAndEncode() = %s", err)
+			}
+			result[k] = encoded
+		}
+		return result
+	}
+	st := store.NewStore(nil, nil, nil)
+	st.SetBaseBt(store.NewMemoryReader(encode(map[string]string{
+		"key1": "foo1",
+		"key2": "foo2",
+	})))
+	st.UpdateBranchBt(store.NewMemoryReader(encode(map[string]string{
+		"key2": "bar2",
+		"key3": "bar3",
+	})))
+	baseDataMap, branchDataMap, err := bigTableReadRowsParallel(
+		ctx,
+		st,
+		bigtable.RowList{"key1", "key2", "key3"},
+		func(dcid string, jsonRaw []byte) (interface{}, error) {
+			return string(jsonRaw), nil
+		},
+		func(key string) (string, error) { return key, nil },
+		true, /* readBranch */
+	)
+	if err != nil {
+		t.Fatalf("bigTableReadRowsParallel got error: %v", err)
+	}
+	if diff := cmp.Diff(map[string]interface{}{"key1": "foo1", "key2": "foo2"},
+		baseDataMap); diff != "" {
+		t.Errorf("base rows got diff %+v", diff)
+	}
+	if diff := cmp.Diff(map[string]interface{}{"key2": "bar2", "key3": "bar3"},
+		branchDataMap); diff != "" {
+		t.Errorf("branch rows got diff %+v", diff)
+	}
+}
+
+func TestReadRowCache(t *testing.T) {
+	ctx := context.Background()
+	encoded, err := util.ZipAndEncode([]byte("foo1"))
+	if err != nil {
+		t.Fatalf("ZipAndEncode() = %s", err)
+	}
+	st := store.NewStore(nil, nil, nil)
+	st.SetBaseBt(store.NewMemoryReader(map[string]string{"key1": encoded}))
+	rowCache := store.NewRowCache(1 << 20)
+	st.SetRowCache(rowCache)
+	for i := 0; i < 2; i++ {
+		baseDataMap, _, err := bigTableReadRowsParallel(
+			ctx,
+			st,
+			bigtable.RowList{"key1", "key2"},
+			func(dcid string, jsonRaw []byte) (interface{}, error) {
+				return string(jsonRaw), nil
+			},
+			func(key string) (string, error) { return key, nil },
+			false, /* readBranch */
+		)
+		if err != nil {
+			t.Fatalf("bigTableReadRowsParallel got error: %v", err)
+		}
+		if diff := cmp.Diff(map[string]interface{}{"key1": "foo1"}, baseDataMap); diff != "" {
+			t.Errorf("read %d got diff %+v", i, diff)
+		}
+	}
+	// key2 is not in the table so it misses both times.
+	got := rowCache.Stats()
+	if got.Hits != 1 || got.Misses != 3 {
+		t.Errorf("Stats() = %+v, want 1 hit and 3 misses", got)
+	}
+}
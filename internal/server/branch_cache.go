@@ -0,0 +1,102 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/datacommonsorg/mixer/internal/store"
+	"github.com/datacommonsorg/mixer/internal/util"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// branchProbeTimeout is the time limit to probe a new branch cache table.
+const branchProbeTimeout = 30 * time.Second
+
+// probeBranchTable checks that a branch cache table is readable, and has all
+// the sentinel rows with valid values. Reading the rows also warms up the
+// connection to the table.
+func probeBranchTable(
+	ctx context.Context, reader store.KVReader, sentinelKeys []string) error {
+	ctx, cancel := context.WithTimeout(ctx, branchProbeTimeout)
+	defer cancel()
+	if len(sentinelKeys) == 0 {
+		if err := reader.PrefixScan(ctx, "", func(string, []byte) bool {
+			return false
+		}); err != nil {
+			return status.Errorf(codes.Unavailable, "Failed to read branch cache: %v", err)
+		}
+		return nil
+	}
+	for _, key := range sentinelKeys {
+		raw, err := reader.Get(ctx, key)
+		if err != nil {
+			return status.Errorf(
+				codes.Unavailable, "Failed to read sentinel row %s: %v", key, err)
+		}
+		if raw == nil {
+			return status.Errorf(codes.FailedPrecondition, "Missing sentinel row %s", key)
+		}
+		if _, err := util.UnzipAndDecode(string(raw)); err != nil {
+			return status.Errorf(
+				codes.FailedPrecondition, "Invalid sentinel row %s: %v", key, err)
+		}
+	}
+	return nil
+}
+
+// openBranchTable opens a table in the branch cache Bigtable instance.
+func (s *Server) openBranchTable(ctx context.Context, table string) (store.KVReader, error) {
+	btTable, err := NewBtTable(
+		ctx, s.metadata.BtProject, s.metadata.BranchBtInstance, table)
+	if err != nil {
+		return nil, err
+	}
+	return store.NewBigtableReader(btTable), nil
+}
+
+// checkBranchTable probes a new branch cache table with the sentinel rows.
+func (s *Server) checkBranchTable(
+	ctx context.Context, table string, reader store.KVReader) error {
+	if err := probeBranchTable(ctx, reader, s.metadata.BranchSentinelKeys); err != nil {
+		return status.Errorf(status.Code(err),
+			"Rejected branch cache %s: %s", table, status.Convert(err).Message())
+	}
+	return nil
+}
+
+// UpdateBranchTable opens a branch cache Bigtable table, and uses it for the
+// default branch cache if it passes the probe of sentinel rows. The current
+// table is kept otherwise. The replaced table is kept for rollback.
+func (s *Server) UpdateBranchTable(ctx context.Context, branchTableName string) error {
+	reader, err := s.openBranchTable(ctx, branchTableName)
+	if err != nil {
+		return err
+	}
+	return s.promoteBranchTable(ctx, branchTableName, reader)
+}
+
+func (s *Server) promoteBranchTable(
+	ctx context.Context, branchTableName string, reader store.KVReader) error {
+	if err := s.checkBranchTable(ctx, branchTableName, reader); err != nil {
+		return err
+	}
+	s.store.UpdateBranchLayer(store.DefaultBranchLayer, branchTableName, reader)
+	log.Printf("Promoted branch cache %s", branchTableName)
+	return nil
+}
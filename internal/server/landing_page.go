@@ -233,8 +233,7 @@ func fetchBtData(
 	}
 
 	// Fetch landing page cache data in parallel.
-	// Landing page cache only exists in base cache
-	baseDataMap, _, err := bigTableReadRowsParallel(
+	dataMap, err := bigTableReadRowsMerged(
 		ctx,
 		s.store,
 		rowList,
@@ -247,14 +246,13 @@ func fetchBtData(
 			return &landingPageData, nil
 		},
 		nil,
-		false, /* readBranch */
 	)
 	if err != nil {
 		return nil, err
 	}
 	// Populate result from landing page cache
 	result := map[string]*pb.StatVarSeries{}
-	for dcid, data := range baseDataMap {
+	for dcid, data := range dataMap {
 		if data == nil {
 			continue
 		}
@@ -0,0 +1,176 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"io/ioutil"
+	"log"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/datacommonsorg/mixer/internal/base"
+	"github.com/datacommonsorg/mixer/internal/translator"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// GetMappings returns the current schema mappings.
+func (m *Metadata) GetMappings() []*base.Mapping {
+	m.mappingLock.RLock()
+	defer m.mappingLock.RUnlock()
+	return m.Mappings
+}
+
+// UpdateMappings swaps in a new set of schema mappings.
+func (m *Metadata) UpdateMappings(mappings []*base.Mapping) {
+	m.mappingLock.Lock()
+	defer m.mappingLock.Unlock()
+	m.Mappings = mappings
+}
+
+// readMappingFiles reads the schema mapping mcf files in a directory.
+func readMappingFiles(schemaPath string) ([]*translator.MappingFile, error) {
+	entries, err := ioutil.ReadDir(schemaPath)
+	if err != nil {
+		return nil, err
+	}
+	files := []*translator.MappingFile{}
+	for _, e := range entries {
+		if !strings.HasSuffix(e.Name(), ".mcf") {
+			continue
+		}
+		content, err := ioutil.ReadFile(filepath.Join(schemaPath, e.Name()))
+		if err != nil {
+			return nil, err
+		}
+		files = append(files, &translator.MappingFile{Name: e.Name(), Content: string(content)})
+	}
+	return files, nil
+}
+
+// mappingFilesDigest gets the digest of the schema mapping files, to detect
+// changes of the files.
+func mappingFilesDigest(files []*translator.MappingFile) string {
+	h := sha256.New()
+	for _, f := range files {
+		h.Write([]byte(f.Name))
+		h.Write([]byte{0})
+		h.Write([]byte(f.Content))
+		h.Write([]byte{0})
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// parseMappingFiles validates and parses the schema mapping files. Validation
+// problems are errors when strict is set, and are logged as warnings
+// otherwise, so that they do not stop the server at startup. The
+// validate-mapping command fails on them instead.
+func parseMappingFiles(
+	files []*translator.MappingFile, bqDataset string, dialect base.Dialect,
+	strict bool) ([]*base.Mapping, error) {
+	if errs := translator.ValidateMapping(files, nil); len(errs) > 0 {
+		msgs := []string{}
+		for _, e := range errs {
+			msgs = append(msgs, e.Error())
+		}
+		if strict {
+			return nil, status.Errorf(
+				codes.InvalidArgument, "Invalid schema mapping:\n%s", strings.Join(msgs, "\n"))
+		}
+		for _, msg := range msgs {
+			log.Printf("Warning: schema mapping problem: %s", msg)
+		}
+	}
+	mappings := []*base.Mapping{}
+	for _, f := range files {
+		mapping, err := translator.ParseMapping(f.Content, bqDataset, dialect)
+		if err != nil {
+			return nil, err
+		}
+		mappings = append(mappings, mapping...)
+	}
+	return mappings, nil
+}
+
+// ReloadMappings re-reads the schema mapping files and swaps in the new
+// mappings. The current mappings are kept if the new ones are invalid.
+func (s *Server) ReloadMappings() error {
+	files, err := readMappingFiles(s.metadata.SchemaPath)
+	if err != nil {
+		return err
+	}
+	return s.reloadMappingFiles(files, true)
+}
+
+// reloadMappingFiles parses the schema mapping files and swaps in the new
+// mappings. Validation problems are errors when strict is set.
+func (s *Server) reloadMappingFiles(files []*translator.MappingFile, strict bool) error {
+	mappings, err := parseMappingFiles(files, s.metadata.Bq, s.metadata.Dialect, strict)
+	if err != nil {
+		return err
+	}
+	// An empty directory or file is more likely a partial update than intended.
+	if len(mappings) == 0 {
+		return status.Errorf(
+			codes.InvalidArgument, "No schema mapping found in %s", s.metadata.SchemaPath)
+	}
+	s.metadata.UpdateMappings(mappings)
+	return nil
+}
+
+// WatchMappings checks the schema mapping files every interval, and reloads
+// the mappings when the files change and stay unchanged for one interval. It
+// returns when ctx is done.
+func (s *Server) WatchMappings(ctx context.Context, interval time.Duration) {
+	var digest, pending string
+	if files, err := readMappingFiles(s.metadata.SchemaPath); err == nil {
+		digest = mappingFilesDigest(files)
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+		files, err := readMappingFiles(s.metadata.SchemaPath)
+		if err != nil {
+			log.Printf("Failed to read schema mapping files: %v", err)
+			continue
+		}
+		newDigest := mappingFilesDigest(files)
+		if newDigest == digest {
+			pending = ""
+			continue
+		}
+		// Wait for the files to settle, in case they are being written.
+		if newDigest != pending {
+			pending = newDigest
+			continue
+		}
+		digest = newDigest
+		pending = ""
+		if err := s.reloadMappingFiles(files, true); err != nil {
+			log.Printf("Rejected schema mapping update: %v", err)
+			continue
+		}
+		log.Printf("Reloaded schema mapping from %s", s.metadata.SchemaPath)
+	}
+}
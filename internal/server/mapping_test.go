@@ -0,0 +1,95 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+const testPlaceMapping = `Node: E:Place->E1
+typeOf: Place
+dcid: C:Place->id
+name: C:Place->name
+functionalDeps: dcid
+`
+
+func TestReloadMappings(t *testing.T) {
+	dir, err := ioutil.TempDir("", "mapping")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	file := filepath.Join(dir, "place.mcf")
+	if err := ioutil.WriteFile(file, []byte(testPlaceMapping), 0644); err != nil {
+		t.Fatal(err)
+	}
+	metadata, err := NewMetadata("dc", "", "", dir)
+	if err != nil {
+		t.Fatalf("NewMetadata() = %s", err)
+	}
+	s := &Server{metadata: metadata}
+	if got := len(metadata.GetMappings()); got != 4 {
+		t.Fatalf("len(GetMappings()) = %d, want 4", got)
+	}
+
+	// Valid change is swapped in.
+	valid := testPlaceMapping[:len(testPlaceMapping)-len("functionalDeps: dcid\n")] +
+		"alternateName: C:Place->alternate_name\nfunctionalDeps: dcid\n"
+	if err := ioutil.WriteFile(file, []byte(valid), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.ReloadMappings(); err != nil {
+		t.Fatalf("ReloadMappings() = %s", err)
+	}
+	if got := len(metadata.GetMappings()); got != 5 {
+		t.Errorf("len(GetMappings()) = %d after reload, want 5", got)
+	}
+
+	// Invalid change is rejected and the current mappings are kept.
+	if err := ioutil.WriteFile(file, []byte("Node: E:Place->E1\ntypeOf: Place\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.ReloadMappings(); err == nil {
+		t.Errorf("ReloadMappings() = nil, want error for missing functionalDeps")
+	}
+	if got := len(metadata.GetMappings()); got != 5 {
+		t.Errorf("len(GetMappings()) = %d after rejected reload, want 5", got)
+	}
+
+	// Validation problems are only warnings at startup.
+	if _, err := NewMetadata("dc", "", "", dir); err != nil {
+		t.Errorf("NewMetadata() = %s, want nil for missing functionalDeps", err)
+	}
+
+	// Watcher picks up the file change.
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go s.WatchMappings(ctx, 10*time.Millisecond)
+	time.Sleep(50 * time.Millisecond)
+	if err := ioutil.WriteFile(file, []byte(testPlaceMapping), 0644); err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 100 && len(metadata.GetMappings()) != 4; i++ {
+		time.Sleep(10 * time.Millisecond)
+	}
+	if got := len(metadata.GetMappings()); got != 4 {
+		t.Errorf("len(GetMappings()) = %d after watch, want 4", got)
+	}
+}
@@ -0,0 +1,255 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	pb "github.com/datacommonsorg/mixer/internal/proto"
+	"github.com/datacommonsorg/mixer/internal/store"
+	"github.com/datacommonsorg/mixer/internal/util"
+)
+
+// newMergePolicy creates the policy to merge base and branch cache rows. Rows
+// of other prefixes, like related locations, use branch-overrides-base.
+//
+// Place relations (BtPlacesInPrefix) are the only rows that are read from the
+// base cache alone, as they are from the base geo imports.
+func newMergePolicy() *store.MergePolicy {
+	return store.NewMergePolicy().
+		Register(util.BtChartDataPrefix, unionSeries).
+		Register(util.BtPlaceObsPrefix, unionSeries).
+		Register(util.BtLandingPagePrefix, unionSeries).
+		Register(util.BtPlaceStatsVarPrefix, store.UnionLists).
+		Register(util.BtArcsPrefix, unionPropLabels).
+		Register(util.BtInPropValPrefix, unionNodes).
+		Register(util.BtOutPropValPrefix, unionNodes).
+		Register(util.BtTriplesPrefix, unionTriples)
+}
+
+// sourceSeriesKey identifies the series of one source in a row.
+type sourceSeriesKey struct {
+	importName        string
+	measurementMethod string
+	observationPeriod string
+	scalingFactor     string
+	unit              string
+	isDcAggregate     bool
+}
+
+func pbSourceSeriesKey(s *pb.SourceSeries) sourceSeriesKey {
+	return sourceSeriesKey{
+		importName:        s.GetImportName(),
+		measurementMethod: s.GetMeasurementMethod(),
+		observationPeriod: s.GetObservationPeriod(),
+		scalingFactor:     s.GetScalingFactor(),
+		unit:              s.GetUnit(),
+		isDcAggregate:     s.GetIsDcAggregate(),
+	}
+}
+
+// unionPbSourceSeries merges the source series of base and branch. A branch
+// series replaces the base series of the same source.
+func unionPbSourceSeries(base, branch []*pb.SourceSeries) []*pb.SourceSeries {
+	index := map[sourceSeriesKey]int{}
+	result := []*pb.SourceSeries{}
+	for _, s := range base {
+		index[pbSourceSeriesKey(s)] = len(result)
+		result = append(result, s)
+	}
+	for _, s := range branch {
+		if i, ok := index[pbSourceSeriesKey(s)]; ok {
+			result[i] = s
+			continue
+		}
+		index[pbSourceSeriesKey(s)] = len(result)
+		result = append(result, s)
+	}
+	return result
+}
+
+// unionSeries merges rows made of series, which are chart data and place
+// observations, by unioning the source series. The branch series replaces the
+// base series from the same source or for the same place. The top level data
+// and provenance of a row are from one side, the branch when it has data, as
+// they are chosen from the series of that row.
+func unionSeries(base, branch interface{}) interface{} {
+	switch b := base.(type) {
+	case *ObsTimeSeries:
+		br, ok := branch.(*ObsTimeSeries)
+		if !ok || b == nil || br == nil {
+			break
+		}
+		index := map[sourceSeriesKey]int{}
+		result := &ObsTimeSeries{
+			Data:          b.Data,
+			PlaceName:     b.PlaceName,
+			PlaceDcid:     b.PlaceDcid,
+			ProvenanceURL: b.ProvenanceURL,
+		}
+		if len(br.Data) > 0 {
+			result.Data = br.Data
+			result.ProvenanceURL = br.ProvenanceURL
+		}
+		for _, series := range [][]*SourceSeries{b.SourceSeries, br.SourceSeries} {
+			for _, s := range series {
+				key := sourceSeriesKey{
+					importName:        s.ImportName,
+					measurementMethod: s.MeasurementMethod,
+					observationPeriod: s.ObservationPeriod,
+					scalingFactor:     s.ScalingFactor,
+					unit:              s.Unit,
+					isDcAggregate:     s.IsDcAggregate,
+				}
+				if i, ok := index[key]; ok {
+					result.SourceSeries[i] = s
+					continue
+				}
+				index[key] = len(result.SourceSeries)
+				result.SourceSeries = append(result.SourceSeries, s)
+			}
+		}
+		if br.PlaceName != "" {
+			result.PlaceName = br.PlaceName
+		}
+		return result
+	case *pb.ObsTimeSeries:
+		br, ok := branch.(*pb.ObsTimeSeries)
+		if !ok || b == nil || br == nil {
+			break
+		}
+		result := &pb.ObsTimeSeries{
+			Data:             b.GetData(),
+			PlaceName:        b.GetPlaceName(),
+			PlaceDcid:        b.GetPlaceDcid(),
+			SourceSeries:     unionPbSourceSeries(b.GetSourceSeries(), br.GetSourceSeries()),
+			ProvenanceDomain: b.GetProvenanceDomain(),
+			ProvenanceUrl:    b.GetProvenanceUrl(),
+		}
+		if len(br.GetData()) > 0 {
+			result.Data = br.GetData()
+			result.ProvenanceDomain = br.GetProvenanceDomain()
+			result.ProvenanceUrl = br.GetProvenanceUrl()
+		}
+		if br.GetPlaceName() != "" {
+			result.PlaceName = br.GetPlaceName()
+		}
+		return result
+	case *pb.StatVarObsSeries:
+		br, ok := branch.(*pb.StatVarObsSeries)
+		if !ok || b == nil || br == nil {
+			break
+		}
+		result := &pb.StatVarObsSeries{Data: map[string]*pb.ObsTimeSeries{}}
+		for sv, series := range b.GetData() {
+			result.Data[sv] = series
+		}
+		for sv, series := range br.GetData() {
+			if baseSeries, ok := result.Data[sv]; ok {
+				result.Data[sv] = unionSeries(baseSeries, series).(*pb.ObsTimeSeries)
+				continue
+			}
+			result.Data[sv] = series
+		}
+		return result
+	case *pb.ObsCollection:
+		br, ok := branch.(*pb.ObsCollection)
+		if !ok || b == nil || br == nil {
+			break
+		}
+		return &pb.ObsCollection{
+			SourceCohorts: unionPbSourceSeries(b.GetSourceCohorts(), br.GetSourceCohorts()),
+		}
+	case *pb.SVOCollection:
+		br, ok := branch.(*pb.SVOCollection)
+		if !ok || b == nil || br == nil {
+			break
+		}
+		index := map[string]int{}
+		result := &pb.SVOCollection{}
+		for _, places := range [][]*pb.SVOPlace{b.GetPlaces(), br.GetPlaces()} {
+			for _, place := range places {
+				if i, ok := index[place.GetDcid()]; ok {
+					result.Places[i] = place
+					continue
+				}
+				index[place.GetDcid()] = len(result.Places)
+				result.Places = append(result.Places, place)
+			}
+		}
+		return result
+	}
+	return store.BranchOverridesBase(base, branch)
+}
+
+// unionPropLabels merges property labels by unioning the in and out labels.
+func unionPropLabels(base, branch interface{}) interface{} {
+	result := &PropLabelCache{InLabels: []string{}, OutLabels: []string{}}
+	for _, data := range []interface{}{base, branch} {
+		labels, ok := data.(*PropLabelCache)
+		if !ok || labels == nil {
+			continue
+		}
+		result.InLabels = util.MergeDedupe(result.InLabels, labels.InLabels)
+		result.OutLabels = util.MergeDedupe(result.OutLabels, labels.OutLabels)
+	}
+	return result
+}
+
+// unionNodes merges property values, which are lists of nodes. A branch node
+// replaces the base node with the same dcid or value.
+func unionNodes(base, branch interface{}) interface{} {
+	baseNodes, ok1 := base.([]*Node)
+	branchNodes, ok2 := branch.([]*Node)
+	if !ok1 || !ok2 {
+		return store.BranchOverridesBase(base, branch)
+	}
+	index := map[[2]string]int{}
+	result := []*Node{}
+	for _, nodes := range [][]*Node{baseNodes, branchNodes} {
+		for _, n := range nodes {
+			key := [2]string{n.Dcid, n.Value}
+			if i, ok := index[key]; ok {
+				result[i] = n
+				continue
+			}
+			index[key] = len(result)
+			result = append(result, n)
+		}
+	}
+	return result
+}
+
+// unionTriples merges the triples of a node. A branch triple replaces the base
+// triple with the same subject, predicate and object.
+func unionTriples(base, branch interface{}) interface{} {
+	baseTriples, ok1 := base.(*TriplesCache)
+	branchTriples, ok2 := branch.(*TriplesCache)
+	if !ok1 || !ok2 || baseTriples == nil || branchTriples == nil {
+		return store.BranchOverridesBase(base, branch)
+	}
+	index := map[[4]string]int{}
+	result := &TriplesCache{Triples: []*Triple{}}
+	for _, triples := range [][]*Triple{baseTriples.Triples, branchTriples.Triples} {
+		for _, t := range triples {
+			key := [4]string{t.SubjectID, t.Predicate, t.ObjectID, t.ObjectValue}
+			if i, ok := index[key]; ok {
+				result.Triples[i] = t
+				continue
+			}
+			index[key] = len(result.Triples)
+			result.Triples = append(result.Triples, t)
+		}
+	}
+	return result
+}
@@ -0,0 +1,242 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"testing"
+
+	pb "github.com/datacommonsorg/mixer/internal/proto"
+	"github.com/datacommonsorg/mixer/internal/util"
+	"github.com/go-test/deep"
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/protobuf/testing/protocmp"
+)
+
+func TestMergePolicyByPrefix(t *testing.T) {
+	p := newMergePolicy()
+	for _, c := range []struct {
+		key    string
+		base   interface{}
+		branch interface{}
+		want   interface{}
+	}{
+		{
+			util.BtPlaceStatsVarPrefix + "geoId/06",
+			[]string{"Count_Person"},
+			[]string{"Count_Household", "Count_Person"},
+			[]string{"Count_Person", "Count_Household"},
+		},
+		{
+			util.BtArcsPrefix + "geoId/06",
+			&PropLabelCache{InLabels: []string{"containedInPlace"}},
+			nil,
+			&PropLabelCache{InLabels: []string{"containedInPlace"}, OutLabels: []string{}},
+		},
+		{
+			util.BtArcsPrefix + "geoId/06",
+			&PropLabelCache{InLabels: []string{"a"}, OutLabels: []string{"b"}},
+			&PropLabelCache{InLabels: []string{"c"}, OutLabels: []string{"b"}},
+			&PropLabelCache{InLabels: []string{"a", "c"}, OutLabels: []string{"b"}},
+		},
+		{
+			util.BtRelatedLocationsSameTypePrefix + "geoId/06^Count_Person",
+			&RelatedPlacesInfo{RelatedPlaces: []string{"geoId/04"}},
+			&RelatedPlacesInfo{RelatedPlaces: []string{"geoId/32"}},
+			&RelatedPlacesInfo{RelatedPlaces: []string{"geoId/32"}},
+		},
+		{
+			util.BtChartDataPrefix + "geoId/06^Count_Person",
+			&ObsTimeSeries{
+				Data: map[string]float64{"2018": 1, "2019": 2},
+				SourceSeries: []*SourceSeries{
+					{ImportName: "census", Val: map[string]float64{"2018": 1}},
+					{ImportName: "acs", Val: map[string]float64{"2018": 3}},
+				},
+			},
+			&ObsTimeSeries{
+				Data:          map[string]float64{"2019": 5},
+				ProvenanceURL: "branch.gov",
+				SourceSeries: []*SourceSeries{
+					{ImportName: "acs", Val: map[string]float64{"2019": 4}},
+					{ImportName: "acs", IsDcAggregate: true, Val: map[string]float64{"2019": 7}},
+					{ImportName: "branch", Val: map[string]float64{"2020": 6}},
+				},
+			},
+			&ObsTimeSeries{
+				Data:          map[string]float64{"2019": 5},
+				ProvenanceURL: "branch.gov",
+				SourceSeries: []*SourceSeries{
+					{ImportName: "census", Val: map[string]float64{"2018": 1}},
+					{ImportName: "acs", Val: map[string]float64{"2019": 4}},
+					{ImportName: "acs", IsDcAggregate: true, Val: map[string]float64{"2019": 7}},
+					{ImportName: "branch", Val: map[string]float64{"2020": 6}},
+				},
+			},
+		},
+		{
+			util.BtChartDataPrefix + "geoId/06^Count_Person",
+			&ObsTimeSeries{
+				Data:          map[string]float64{"2018": 1},
+				ProvenanceURL: "census.gov",
+				SourceSeries: []*SourceSeries{
+					{ImportName: "census", Val: map[string]float64{"2018": 1}},
+				},
+			},
+			&ObsTimeSeries{
+				SourceSeries: []*SourceSeries{
+					{ImportName: "branch", Val: map[string]float64{"2020": 6}},
+				},
+			},
+			&ObsTimeSeries{
+				Data:          map[string]float64{"2018": 1},
+				ProvenanceURL: "census.gov",
+				SourceSeries: []*SourceSeries{
+					{ImportName: "census", Val: map[string]float64{"2018": 1}},
+					{ImportName: "branch", Val: map[string]float64{"2020": 6}},
+				},
+			},
+		},
+		{
+			util.BtOutPropValPrefix + "geoId/06^containedInPlace",
+			[]*Node{{Dcid: "country/USA", Name: "USA"}, {Value: "x"}},
+			[]*Node{{Dcid: "country/USA", Name: "United States"}, {Dcid: "usc/PacificDivision"}},
+			[]*Node{
+				{Dcid: "country/USA", Name: "United States"},
+				{Value: "x"},
+				{Dcid: "usc/PacificDivision"},
+			},
+		},
+		{
+			util.BtTriplesPrefix + "geoId/06",
+			&TriplesCache{Triples: []*Triple{
+				{SubjectID: "geoId/06", Predicate: "name", ObjectValue: "California"},
+			}},
+			&TriplesCache{Triples: []*Triple{
+				{SubjectID: "geoId/06", Predicate: "name", ObjectValue: "California", ProvenanceID: "dc/p2"},
+				{SubjectID: "geoId/06", Predicate: "containedInPlace", ObjectID: "country/USA"},
+			}},
+			&TriplesCache{Triples: []*Triple{
+				{SubjectID: "geoId/06", Predicate: "name", ObjectValue: "California", ProvenanceID: "dc/p2"},
+				{SubjectID: "geoId/06", Predicate: "containedInPlace", ObjectID: "country/USA"},
+			}},
+		},
+	} {
+		got := p.Get(c.key)(c.base, c.branch)
+		if diff := deep.Equal(c.want, got); diff != nil {
+			t.Errorf("merge(%s) unexpected diff %v", c.key, diff)
+		}
+	}
+}
+
+func TestUnionSeriesPb(t *testing.T) {
+	for _, c := range []struct {
+		base   interface{}
+		branch interface{}
+		want   interface{}
+	}{
+		{
+			&pb.ObsTimeSeries{
+				PlaceName: "California",
+				SourceSeries: []*pb.SourceSeries{
+					{ImportName: "census", Unit: "USD", Val: map[string]float64{"2018": 1}},
+					{ImportName: "census", Val: map[string]float64{"2018": 2}},
+				},
+			},
+			&pb.ObsTimeSeries{
+				SourceSeries: []*pb.SourceSeries{
+					{ImportName: "census", Val: map[string]float64{"2019": 3}},
+				},
+			},
+			&pb.ObsTimeSeries{
+				PlaceName: "California",
+				SourceSeries: []*pb.SourceSeries{
+					{ImportName: "census", Unit: "USD", Val: map[string]float64{"2018": 1}},
+					{ImportName: "census", Val: map[string]float64{"2019": 3}},
+				},
+			},
+		},
+		{
+			&pb.ObsTimeSeries{
+				Data:          map[string]float64{"2018": 1, "2019": 2},
+				ProvenanceUrl: "census.gov",
+				SourceSeries: []*pb.SourceSeries{
+					{ImportName: "census", Val: map[string]float64{"2018": 1, "2019": 2}},
+				},
+			},
+			&pb.ObsTimeSeries{
+				Data:          map[string]float64{"2020": 3},
+				ProvenanceUrl: "branch.gov",
+				SourceSeries: []*pb.SourceSeries{
+					{ImportName: "branch", Val: map[string]float64{"2020": 3}},
+				},
+			},
+			&pb.ObsTimeSeries{
+				Data:          map[string]float64{"2020": 3},
+				ProvenanceUrl: "branch.gov",
+				SourceSeries: []*pb.SourceSeries{
+					{ImportName: "census", Val: map[string]float64{"2018": 1, "2019": 2}},
+					{ImportName: "branch", Val: map[string]float64{"2020": 3}},
+				},
+			},
+		},
+		{
+			&pb.ObsCollection{
+				SourceCohorts: []*pb.SourceSeries{
+					{ImportName: "census", Val: map[string]float64{"geoId/06": 1}},
+				},
+			},
+			nil,
+			&pb.ObsCollection{
+				SourceCohorts: []*pb.SourceSeries{
+					{ImportName: "census", Val: map[string]float64{"geoId/06": 1}},
+				},
+			},
+		},
+		{
+			&pb.SVOCollection{Places: []*pb.SVOPlace{
+				{Dcid: "geoId/06", Name: "California"},
+				{Dcid: "geoId/32", Name: "Nevada"},
+			}},
+			&pb.SVOCollection{Places: []*pb.SVOPlace{
+				{Dcid: "geoId/32", Name: "Nevada, branch"},
+				{Dcid: "geoId/04", Name: "Arizona"},
+			}},
+			&pb.SVOCollection{Places: []*pb.SVOPlace{
+				{Dcid: "geoId/06", Name: "California"},
+				{Dcid: "geoId/32", Name: "Nevada, branch"},
+				{Dcid: "geoId/04", Name: "Arizona"},
+			}},
+		},
+		{
+			&pb.StatVarObsSeries{Data: map[string]*pb.ObsTimeSeries{
+				"Count_Person": {SourceSeries: []*pb.SourceSeries{{ImportName: "census"}}},
+				"Median_Age":   {SourceSeries: []*pb.SourceSeries{{ImportName: "census"}}},
+			}},
+			&pb.StatVarObsSeries{Data: map[string]*pb.ObsTimeSeries{
+				"Count_Person": {SourceSeries: []*pb.SourceSeries{{ImportName: "branch"}}},
+			}},
+			&pb.StatVarObsSeries{Data: map[string]*pb.ObsTimeSeries{
+				"Count_Person": {SourceSeries: []*pb.SourceSeries{
+					{ImportName: "census"}, {ImportName: "branch"}}},
+				"Median_Age": {SourceSeries: []*pb.SourceSeries{{ImportName: "census"}}},
+			}},
+		},
+	} {
+		got := unionSeries(c.base, c.branch)
+		if diff := cmp.Diff(c.want, got, protocmp.Transform()); diff != "" {
+			t.Errorf("unionSeries(%v, %v) got diff %v", c.base, c.branch, diff)
+		}
+	}
+}
@@ -18,6 +18,7 @@ import (
 	"context"
 	"encoding/json"
 
+	"github.com/datacommonsorg/mixer/internal/base"
 	pb "github.com/datacommonsorg/mixer/internal/proto"
 	"github.com/datacommonsorg/mixer/internal/sparql"
 	"github.com/datacommonsorg/mixer/internal/translator"
@@ -33,7 +34,16 @@ func (s *Server) Translate(ctx context.Context,
 	}
 
 	out := pb.TranslateResponse{}
-	mappings, err := translator.ParseMapping(in.GetSchemaMapping(), "bq")
+	dialect, err := base.GetDialect(in.GetDialect())
+	if err != nil {
+		return nil, err
+	}
+	// Tables are in the default database of other dialects.
+	db := "bq"
+	if dialect != base.BigQuery {
+		db = ""
+	}
+	mappings, err := translator.ParseMapping(in.GetSchemaMapping(), db, dialect)
 	if err != nil {
 		return nil, err
 	}
@@ -41,6 +51,18 @@ func (s *Server) Translate(ctx context.Context,
 	if err != nil {
 		return nil, err
 	}
+	opts.Dialect = dialect
+	opts.ContainedIn = s.metadata.ContainedIn
+	if in.GetExplain() {
+		plan := translator.Explain(mappings, nodes, queries, s.metadata.SubTypeMap, opts)
+		planJSON, err := json.MarshalIndent(plan, "", "  ")
+		if err != nil {
+			return nil, err
+		}
+		out.Sql = plan.SQL
+		out.Plan = string(planJSON)
+		return &out, nil
+	}
 	trans, err := translator.Translate(
 		mappings, nodes, queries, s.metadata.SubTypeMap, opts)
 	if err != nil {
@@ -100,6 +100,9 @@ type SourceSeries struct {
 	Unit              string             `json:"unit,omitempty"`
 	ProvenanceURL     string             `json:"provenanceUrl,omitempty"`
 	Val               map[string]float64 `json:"val,omitempty"`
+	// IsDcAggregate identifies the series with the other source fields when
+	// merging cache rows. It is not in the API response.
+	IsDcAggregate bool `json:"-"`
 }
 
 // ObsTimeSeries repesents multiple time series data.
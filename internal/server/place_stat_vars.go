@@ -21,7 +21,6 @@ import (
 	"encoding/json"
 
 	pb "github.com/datacommonsorg/mixer/internal/proto"
-	"github.com/datacommonsorg/mixer/internal/util"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
@@ -53,7 +52,7 @@ func (s *Server) GetPlaceStatVars(
 		return nil, status.Error(codes.InvalidArgument, "Missing required arguments: dcid")
 	}
 	rowList := buildPlaceStatsVarKey(dcids)
-	baseDataMap, branchDataMap, err := bigTableReadRowsParallel(
+	dataMap, err := bigTableReadRowsMerged(
 		ctx,
 		s.store,
 		rowList,
@@ -66,7 +65,6 @@ func (s *Server) GetPlaceStatVars(
 			return data.StatVarIds, nil
 		},
 		nil,
-		true, /* readBranch */
 	)
 	if err != nil {
 		return nil, err
@@ -74,12 +72,8 @@ func (s *Server) GetPlaceStatVars(
 	resp := pb.GetPlaceStatVarsResponse{Places: map[string]*pb.StatVars{}}
 	for _, dcid := range dcids {
 		resp.Places[dcid] = &pb.StatVars{StatVars: []string{}}
-		if baseDataMap[dcid] != nil {
-			resp.Places[dcid].StatVars = baseDataMap[dcid].([]string)
-		}
-		if branchDataMap[dcid] != nil {
-			resp.Places[dcid].StatVars = util.MergeDedupe(
-				resp.Places[dcid].StatVars, baseDataMap[dcid].([]string))
+		if dataMap[dcid] != nil {
+			resp.Places[dcid].StatVars = dataMap[dcid].([]string)
 		}
 	}
 	return &resp, nil
@@ -44,7 +44,8 @@ func (s *Server) GetPlacesIn(ctx context.Context, in *pb.GetPlacesInRequest) (
 
 	rowList := buildPlaceInKey(dcids, placeType)
 
-	// Place relations are from base geo imports. Only trust the base cache.
+	// Place relations are from base geo imports. Only trust the base cache, as
+	// noted in newMergePolicy.
 	baseDataMap, _, err := bigTableReadRowsParallel(
 		ctx,
 		s.store,
@@ -116,8 +117,7 @@ func (s *Server) GetRelatedLocations(ctx context.Context,
 				"%s%s^%s", prefix, in.GetDcid(), statVarDcid))
 		}
 	}
-	// RelatedPlace cache only exists in base cache
-	baseDataMap, _, err := bigTableReadRowsParallel(
+	dataMap, err := bigTableReadRowsMerged(
 		ctx,
 		s.store,
 		rowList,
@@ -137,13 +137,12 @@ func (s *Server) GetRelatedLocations(ctx context.Context,
 			}
 			return parts[len(parts)-1], nil
 		},
-		false, /* readBranch */
 	)
 	if err != nil {
 		return nil, err
 	}
 	results := map[string]*RelatedPlacesInfo{}
-	for statVarDcid, data := range baseDataMap {
+	for statVarDcid, data := range dataMap {
 		if data == nil {
 			results[statVarDcid] = nil
 		} else {
@@ -176,8 +175,7 @@ func (s *Server) GetLocationsRankings(ctx context.Context,
 			rowList = append(rowList, fmt.Sprintf("%s%s^%s^%s", prefix, "*", in.GetPlaceType(), statVarDcid))
 		}
 	}
-	// RelatedPlace cache only exists in base cache
-	baseDataMap, _, err := bigTableReadRowsParallel(
+	dataMap, err := bigTableReadRowsMerged(
 		ctx,
 		s.store,
 		rowList,
@@ -197,14 +195,13 @@ func (s *Server) GetLocationsRankings(ctx context.Context,
 			}
 			return parts[len(parts)-1], nil
 		},
-		false, /* readBranch */
 	)
 	if err != nil {
 		return nil, err
 	}
 
 	results := map[string]*pb.RelatedPlacesInfo{}
-	for statVarDcid, data := range baseDataMap {
+	for statVarDcid, data := range dataMap {
 		if data == nil {
 			results[statVarDcid] = nil
 		} else {
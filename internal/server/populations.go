@@ -49,7 +49,7 @@ curl --request POST \
 // GetPlaceObs implements API for Mixer.GetPlaceObs.
 func (s *Server) GetPlaceObs(ctx context.Context, in *pb.GetPlaceObsRequest) (
 	*pb.SVOCollection, error) {
-	if s.store.BaseBt() == nil || s.store.BranchBt() == nil {
+	if s.store.BaseBt() == nil {
 		return nil, status.Errorf(
 			codes.NotFound, "Bigtable instance is not specified")
 	}
@@ -66,60 +66,38 @@ func (s *Server) GetPlaceObs(ctx context.Context, in *pb.GetPlaceObsRequest) (
 	key := fmt.Sprintf("%s%s^%s^%s", util.BtPlaceObsPrefix, in.GetPlaceType(),
 		in.GetStatVar(), in.GetDate())
 
-	// TODO(boxu): abstract out the common logic for handling cache merging.
-	baseData := &pb.SVOCollection{}
-	branchData := &pb.SVOCollection{}
-	var baseRaw, branchRaw []byte
-	var hasBaseData, hasBranchData bool
-
-	btRow, err := s.store.BranchBt().ReadRow(ctx, key)
-	if err != nil {
-		return nil, err
-	}
-	hasBranchData = len(btRow[util.BtFamily]) > 0
-	if hasBranchData {
-		branchRaw = btRow[util.BtFamily][0].Value
-		if tmp, err := util.UnzipAndDecode(string(branchRaw)); err == nil {
-			err := protojson.Unmarshal(tmp, branchData)
-			if err != nil {
+	dataMap, err := bigTableReadRowsMerged(
+		ctx,
+		s.store,
+		[]string{key},
+		func(token string, jsonRaw []byte) (interface{}, error) {
+			var data pb.SVOCollection
+			if err := protojson.Unmarshal(jsonRaw, &data); err != nil {
 				return nil, err
 			}
-		}
-	}
-
-	btRow, err = s.store.BaseBt().ReadRow(ctx, key)
+			return &data, nil
+		},
+		func(key string) (string, error) { return key, nil },
+	)
 	if err != nil {
 		return nil, err
 	}
-	hasBaseData = len(btRow[util.BtFamily]) > 0
-	if hasBaseData {
-		baseRaw = btRow[util.BtFamily][0].Value
-		if tmp, err := util.UnzipAndDecode(string(baseRaw)); err == nil {
-			err := protojson.Unmarshal(tmp, baseData)
-			if err != nil {
-				return nil, err
-			}
-		}
-	}
-	dataMap := map[string]*pb.SVOPlace{}
-	for _, data := range baseData.Places {
-		dataMap[data.Dcid] = data
-	}
-	if hasBranchData {
-		for _, data := range branchData.Places {
-			dataMap[data.Dcid] = data
+	placeMap := map[string]*pb.SVOPlace{}
+	if data, ok := dataMap[key]; ok && data != nil {
+		for _, place := range data.(*pb.SVOCollection).GetPlaces() {
+			placeMap[place.GetDcid()] = place
 		}
 	}
 
 	places := []string{}
-	for place := range dataMap {
+	for place := range placeMap {
 		places = append(places, place)
 	}
 	sort.Strings(places)
 
 	res := &pb.SVOCollection{}
 	for _, place := range places {
-		res.Places = append(res.Places, dataMap[place])
+		res.Places = append(res.Places, placeMap[place])
 	}
-	return res, err
+	return res, nil
 }
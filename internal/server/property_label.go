@@ -37,7 +37,7 @@ func (s *Server) GetPropertyLabels(ctx context.Context,
 
 	rowList := buildPropertyLabelKey(dcids)
 
-	baseDataMap, branchDataMap, err := bigTableReadRowsParallel(
+	dataMap, err := bigTableReadRowsMerged(
 		ctx,
 		s.store,
 		rowList,
@@ -50,26 +50,16 @@ func (s *Server) GetPropertyLabels(ctx context.Context,
 			return &propLabels, nil
 		},
 		nil,
-		true, /* readBranch */
 	)
 	if err != nil {
 		return nil, err
 	}
 	result := map[string]*PropLabelCache{}
 	for _, dcid := range dcids {
-		result[dcid] = &PropLabelCache{InLabels: []string{}, OutLabels: []string{}}
-		// Merge cache value from base and branch cache
-		for _, m := range []map[string]interface{}{baseDataMap, branchDataMap} {
-			if data, ok := m[dcid]; ok {
-				if data.(*PropLabelCache).InLabels != nil {
-					result[dcid].InLabels = util.MergeDedupe(
-						result[dcid].InLabels, data.(*PropLabelCache).InLabels)
-				}
-				if data.(*PropLabelCache).OutLabels != nil {
-					result[dcid].OutLabels = util.MergeDedupe(
-						result[dcid].OutLabels, data.(*PropLabelCache).OutLabels)
-				}
-			}
+		if data, ok := dataMap[dcid]; ok && data != nil {
+			result[dcid] = data.(*PropLabelCache)
+		} else {
+			result[dcid] = &PropLabelCache{InLabels: []string{}, OutLabels: []string{}}
 		}
 	}
 	jsonRaw, err := json.Marshal(result)
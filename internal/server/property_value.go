@@ -141,9 +141,7 @@ func readPropertyValues(
 	store *store.Store,
 	rowList bigtable.RowList,
 ) (map[string][]*Node, error) {
-	// Only read property value from base cache.
-	// Branch cache only contains supplement data but not other properties yet.
-	baseDataMap, _, err := bigTableReadRowsParallel(
+	dataMap, err := bigTableReadRowsMerged(
 		ctx,
 		store,
 		rowList,
@@ -156,13 +154,12 @@ func readPropertyValues(
 			return propVals.Nodes, nil
 		},
 		nil,
-		false, /* readBranch */
 	)
 	if err != nil {
 		return nil, err
 	}
 	result := map[string][]*Node{}
-	for dcid, data := range baseDataMap {
+	for dcid, data := range dataMap {
 		if data != nil {
 			result[dcid] = data.([]*Node)
 		}
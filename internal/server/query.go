@@ -16,45 +16,213 @@ package server
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
 	"fmt"
+	"strings"
 
 	"cloud.google.com/go/bigquery"
+	"github.com/datacommonsorg/mixer/internal/base"
 	"github.com/datacommonsorg/mixer/internal/sparql"
+	"github.com/datacommonsorg/mixer/internal/store"
 	"github.com/datacommonsorg/mixer/internal/translator"
 
 	pb "github.com/datacommonsorg/mixer/internal/proto"
 
 	"google.golang.org/api/iterator"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
+// Page size used when a page token is given without a page size.
+const defaultQueryPageSize = 1000
+
+// queryPageToken identifies the next page of a query result. A BigQuery result
+// is identified by the query job, and other results by the SQL digest and the
+// row offset.
+type queryPageToken struct {
+	JobID     string `json:"job_id,omitempty"`
+	Location  string `json:"location,omitempty"`
+	PageToken string `json:"page_token,omitempty"`
+	SQLDigest string `json:"sql_digest,omitempty"`
+	Offset    int64  `json:"offset,omitempty"`
+}
+
+func encodeQueryPageToken(token *queryPageToken) (string, error) {
+	b, err := json.Marshal(token)
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+func decodeQueryPageToken(s string) (*queryPageToken, error) {
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid page_token %s", s)
+	}
+	token := &queryPageToken{}
+	if err := json.Unmarshal(b, token); err != nil ||
+		(token.JobID == "" && token.SQLDigest == "") {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid page_token %s", s)
+	}
+	return token, nil
+}
+
+// Number of rows in each QueryStream response.
+const queryStreamBatchSize = 1000
+
+// translateSparql translates a SELECT sparql query to SQL with the server
+// mappings.
+func (s *Server) translateSparql(sparqlQuery string) (*translator.Translation, error) {
+	nodes, queries, opts, err := sparql.ParseQuery(sparqlQuery)
+	if err != nil {
+		return nil, err
+	}
+	if opts.Form != "" {
+		return nil, status.Errorf(
+			codes.InvalidArgument, "Only SELECT query is supported, got %s", opts.Form)
+	}
+	return s.translateQuery(nodes, queries, opts)
+}
+
+// translateQuery translates a parsed query to SQL with the server mappings and
+// SQL dialect.
+func (s *Server) translateQuery(
+	nodes []base.Node, queries []*base.Query, opts *base.QueryOptions) (
+	*translator.Translation, error) {
+	opts.Dialect = s.metadata.Dialect
+	opts.ContainedIn = s.metadata.ContainedIn
+	return translator.Translate(
+		s.metadata.GetMappings(), nodes, queries, s.metadata.SubTypeMap, opts)
+}
+
+// bigQueryClient gets the BigQuery client when queries run on BigQuery, or nil
+// otherwise.
+func (s *Server) bigQueryClient() *bigquery.Client {
+	if e, ok := s.store.Executor.(*store.BigQueryExecutor); ok {
+		return e.Client
+	}
+	return nil
+}
+
+// getQueryHeader gets the response header from the selected nodes.
+func getQueryHeader(translation *translator.Translation) []string {
+	header := []string{}
+	for _, node := range translation.Nodes {
+		header = append(header, node.Alias)
+	}
+	return header
+}
+
 // Query implements API for Mixer.Query.
 func (s *Server) Query(
 	ctx context.Context, in *pb.QueryRequest) (*pb.QueryResponse, error) {
+	return s.query(ctx, in, true)
+}
+
+// query runs a sparql query. The byte limit only applies to the queries of
+// API users, which are checked with checkCost, and not to the internal queries
+// of other APIs.
+func (s *Server) query(
+	ctx context.Context, in *pb.QueryRequest, checkCost bool) (
+	*pb.QueryResponse, error) {
 	nodes, queries, opts, err := sparql.ParseQuery(in.GetSparql())
 	if err != nil {
 		return nil, err
 	}
-
-	translation, err := translator.Translate(
-		s.metadata.Mappings, nodes, queries, s.metadata.SubTypeMap, opts)
+	format := strings.ToLower(in.GetFormat())
+	if format != "" {
+		if err := checkQueryResultFormat(format); err != nil {
+			return nil, err
+		}
+		if err := checkResultVars(nodes); err != nil {
+			return nil, err
+		}
+	}
+	if opts.Form != "" {
+		if format != "" {
+			return nil, status.Errorf(
+				codes.InvalidArgument, "Result format is not supported for %s query", opts.Form)
+		}
+		if in.GetPageSize() > 0 || in.GetPageToken() != "" {
+			return nil, status.Errorf(
+				codes.InvalidArgument, "Pagination is not supported for %s query", opts.Form)
+		}
+		if in.GetDryRun() {
+			return nil, status.Errorf(
+				codes.InvalidArgument, "Dry run is not supported for %s query", opts.Form)
+		}
+		triples, err := s.queryTriples(ctx, nodes, queries, opts, in, checkCost)
+		if err != nil {
+			return nil, err
+		}
+		return &pb.QueryResponse{Triples: triples}, nil
+	}
+	translation, err := s.translateQuery(nodes, queries, opts)
 	if err != nil {
 		return nil, err
 	}
 
 	var out pb.QueryResponse
-	for _, node := range translation.Nodes {
-		out.Header = append(out.Header, node.Alias)
+	out.Header = getQueryHeader(translation)
+
+	if in.GetDryRun() {
+		cost, err := s.estimateQuery(ctx, translation.SQL)
+		if err != nil {
+			return nil, err
+		}
+		limit := getQueryByteLimit(s.metadata.MaxQueryBytes, in)
+		if err := checkQueryBytes(cost, limit); err != nil {
+			return nil, err
+		}
+		out.TotalBytesProcessed = cost.TotalBytesProcessed
+		out.ReferencedTables = cost.ReferencedTables
+		return &out, nil
+	}
+	// Following pages read the result of a query that has already run.
+	if checkCost && in.GetPageToken() == "" {
+		if err := s.checkQueryCost(ctx, translation.SQL, in); err != nil {
+			return nil, err
+		}
+	}
+
+	if in.GetPageSize() > 0 || in.GetPageToken() != "" {
+		out.Rows, out.NextPageToken, err = s.readQueryPage(ctx, translation, opts.Orderby, in)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		out.Rows, err = s.readQueryRows(ctx, translation)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if format != "" {
+		out.Payload, out.ContentType, err = formatQueryResult(format, &out, translation)
+		if err != nil {
+			return nil, err
+		}
+		out.Header = nil
+		out.Rows = nil
 	}
-	out.Rows = []*pb.QueryResponseRow{}
-	n := len(out.Header)
+	return &out, nil
+}
 
-	q := s.store.BqClient.Query(translation.SQL)
-	it, err := q.Read(ctx)
+// readQueryRows runs the translated query and reads all the result rows.
+func (s *Server) readQueryRows(
+	ctx context.Context, translation *translator.Translation) (
+	[]*pb.QueryResponseRow, error) {
+	result := []*pb.QueryResponseRow{}
+	it, err := s.store.Executor.Query(ctx, translation.SQL)
 	if err != nil {
 		return nil, err
 	}
+	defer it.Close()
 	for {
-		responseRow := pb.QueryResponseRow{}
 		var row []bigquery.Value
 		err := it.Next(&row)
 		if err == iterator.Done {
@@ -63,32 +231,258 @@ func (s *Server) Query(
 		if err != nil {
 			return nil, err
 		}
-		for i, cell := range row {
-			var str string
-			if cell != nil {
-				if x, ok := cell.(int64); ok {
-					str = fmt.Sprintf("%v", x)
-				}
-				if x, ok := cell.(float64); ok {
-					str = fmt.Sprintf("%v", x)
-				}
-				if x, ok := cell.(string); ok {
-					str = x
-				}
+		result = append(result, toQueryResponseRow(row, it.Schema(), translation))
+	}
+	return result, nil
+}
+
+// QueryStream implements API for Mixer.QueryStream.
+func (s *Server) QueryStream(
+	in *pb.QueryRequest, stream pb.Mixer_QueryStreamServer) error {
+	if in.GetDryRun() {
+		return status.Errorf(codes.InvalidArgument, "Dry run is not supported for QueryStream")
+	}
+	if in.GetFormat() != "" {
+		return status.Errorf(codes.InvalidArgument, "Result format is not supported for QueryStream")
+	}
+	if in.GetPageSize() > 0 || in.GetPageToken() != "" {
+		return status.Errorf(codes.InvalidArgument, "Pagination is not supported for QueryStream")
+	}
+	translation, err := s.translateSparql(in.GetSparql())
+	if err != nil {
+		return err
+	}
+	if err := s.checkQueryCost(stream.Context(), translation.SQL, in); err != nil {
+		return err
+	}
+	err = stream.Send(&pb.QueryStreamResponse{Header: getQueryHeader(translation)})
+	if err != nil {
+		return err
+	}
+
+	it, err := s.store.Executor.Query(stream.Context(), translation.SQL)
+	if err != nil {
+		return err
+	}
+	defer it.Close()
+	batch := &pb.QueryStreamResponse{}
+	for {
+		var row []bigquery.Value
+		err := it.Next(&row)
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		batch.Rows = append(batch.Rows, toQueryResponseRow(row, it.Schema(), translation))
+		if len(batch.Rows) == queryStreamBatchSize {
+			if err := stream.Send(batch); err != nil {
+				return err
 			}
-			if i < n {
-				responseRow.Cells = append(
-					responseRow.Cells, &pb.QueryResponseCell{Value: str})
-			} else {
-				// Add provenance to corresponding cells.
-				if idx, ok := translation.Prov[i]; ok {
-					for _, j := range idx {
-						responseRow.Cells[j].ProvenanceId = str
-					}
+			batch = &pb.QueryStreamResponse{}
+		}
+	}
+	if len(batch.Rows) > 0 {
+		return stream.Send(batch)
+	}
+	return nil
+}
+
+// readQueryPage reads one page of the query result. On BigQuery, the query job
+// is started for the first page and looked up from the page token for
+// following pages. On other databases, the rows are sorted by the sort keys
+// of the query.
+func (s *Server) readQueryPage(
+	ctx context.Context, translation *translator.Translation, orderby []*base.Orderby,
+	in *pb.QueryRequest) ([]*pb.QueryResponseRow, string, error) {
+	sql := translation.SQL
+	pageSize := int(in.GetPageSize())
+	if pageSize <= 0 {
+		pageSize = defaultQueryPageSize
+	}
+	client := s.bigQueryClient()
+	if client == nil {
+		return s.readSQLQueryPage(ctx, translation, orderby, in.GetPageToken(), pageSize)
+	}
+	var job *bigquery.Job
+	token := &queryPageToken{}
+	if in.GetPageToken() == "" {
+		var err error
+		job, err = client.Query(sql).Run(ctx)
+		if err != nil {
+			return nil, "", err
+		}
+	} else {
+		var err error
+		token, err = decodeQueryPageToken(in.GetPageToken())
+		if err != nil {
+			return nil, "", err
+		}
+		if token.JobID == "" {
+			return nil, "", status.Errorf(
+				codes.InvalidArgument, "page_token does not match the sparql query")
+		}
+		job, err = client.JobFromIDLocation(ctx, token.JobID, token.Location)
+		if err != nil {
+			return nil, "", err
+		}
+		config, err := job.Config()
+		if err != nil {
+			return nil, "", err
+		}
+		if q, ok := config.(*bigquery.QueryConfig); !ok || q.Q != sql {
+			return nil, "", status.Errorf(
+				codes.InvalidArgument, "page_token does not match the sparql query")
+		}
+	}
+	it, err := job.Read(ctx)
+	if err != nil {
+		return nil, "", err
+	}
+	rows := [][]bigquery.Value{}
+	nextPageToken, err := iterator.NewPager(it, pageSize, token.PageToken).NextPage(&rows)
+	if err != nil {
+		return nil, "", err
+	}
+	result := []*pb.QueryResponseRow{}
+	for _, row := range rows {
+		result = append(result, toQueryResponseRow(row, it.Schema, translation))
+	}
+	if nextPageToken == "" {
+		return result, "", nil
+	}
+	next, err := encodeQueryPageToken(&queryPageToken{
+		JobID:     job.ID(),
+		Location:  job.Location(),
+		PageToken: nextPageToken,
+	})
+	if err != nil {
+		return nil, "", err
+	}
+	return result, next, nil
+}
+
+// getPageOrderSQL gets the ORDER BY clause that sorts the rows of a query for
+// reading pages. The order of a subquery is not kept by the outer query, so the
+// sort keys of the query are applied again, by column position. All the
+// columns follow as tie breakers, so that rows are in the same order on every
+// page.
+func getPageOrderSQL(
+	translation *translator.Translation, orderby []*base.Orderby) (string, error) {
+	ordered := map[int]bool{}
+	keys := []string{}
+	for _, o := range orderby {
+		idx := -1
+		for i, n := range translation.Nodes {
+			if n == o.Node {
+				idx = i
+				break
+			}
+		}
+		if idx < 0 {
+			return "", status.Errorf(codes.InvalidArgument,
+				"Order by variable %s must be selected to read pages", o.Node.Alias)
+		}
+		if ordered[idx] {
+			continue
+		}
+		ordered[idx] = true
+		if o.ASC {
+			keys = append(keys, fmt.Sprintf("%d ASC", idx+1))
+		} else {
+			keys = append(keys, fmt.Sprintf("%d DESC", idx+1))
+		}
+	}
+	for i := 0; i < len(translation.Nodes)+len(translation.Prov); i++ {
+		if !ordered[i] {
+			keys = append(keys, fmt.Sprintf("%d ASC", i+1))
+		}
+	}
+	return " ORDER BY " + strings.Join(keys, ", "), nil
+}
+
+// readSQLQueryPage reads one page of the query result from a database other
+// than BigQuery. The page is read by running the query with an offset, which
+// is kept in the page token. Pages are only consistent when the data does not
+// change between the queries.
+func (s *Server) readSQLQueryPage(
+	ctx context.Context, translation *translator.Translation, orderby []*base.Orderby,
+	pageToken string, pageSize int) ([]*pb.QueryResponseRow, string, error) {
+	digest := sha256.Sum256([]byte(translation.SQL))
+	sqlDigest := hex.EncodeToString(digest[:])
+	token := &queryPageToken{SQLDigest: sqlDigest}
+	if pageToken != "" {
+		var err error
+		token, err = decodeQueryPageToken(pageToken)
+		if err != nil {
+			return nil, "", err
+		}
+		if token.SQLDigest != sqlDigest {
+			return nil, "", status.Errorf(
+				codes.InvalidArgument, "page_token does not match the sparql query")
+		}
+	}
+	orderSQL, err := getPageOrderSQL(translation, orderby)
+	if err != nil {
+		return nil, "", err
+	}
+	// Read one more row to know whether there is a next page.
+	sql := fmt.Sprintf("SELECT * FROM (%s) AS page%s LIMIT %d OFFSET %d",
+		translation.SQL, orderSQL, pageSize+1, token.Offset)
+	it, err := s.store.Executor.Query(ctx, sql)
+	if err != nil {
+		return nil, "", err
+	}
+	defer it.Close()
+	result := []*pb.QueryResponseRow{}
+	for len(result) <= pageSize {
+		var row []bigquery.Value
+		err := it.Next(&row)
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			return nil, "", err
+		}
+		result = append(result, toQueryResponseRow(row, it.Schema(), translation))
+	}
+	if len(result) <= pageSize {
+		return result, "", nil
+	}
+	next, err := encodeQueryPageToken(&queryPageToken{
+		SQLDigest: sqlDigest,
+		Offset:    token.Offset + int64(pageSize),
+	})
+	if err != nil {
+		return nil, "", err
+	}
+	return result[:pageSize], next, nil
+}
+
+// toQueryResponseRow converts a BigQuery result row to a QueryResponseRow. The
+// schema gives the column types of the row and can be nil.
+func toQueryResponseRow(
+	row []bigquery.Value, schema bigquery.Schema,
+	translation *translator.Translation) *pb.QueryResponseRow {
+	responseRow := pb.QueryResponseRow{}
+	n := len(translation.Nodes)
+	for i, v := range row {
+		var field *bigquery.FieldSchema
+		if i < len(schema) {
+			field = schema[i]
+		}
+		cell := toQueryResponseCell(v, field)
+		if i < n {
+			responseRow.Cells = append(responseRow.Cells, cell)
+		} else {
+			// Add provenance to corresponding cells.
+			if idx, ok := translation.Prov[i]; ok {
+				for _, j := range idx {
+					responseRow.Cells[j].ProvenanceId = cell.Value
 				}
 			}
 		}
-		out.Rows = append(out.Rows, &responseRow)
 	}
-	return &out, nil
+	return &responseRow
 }
@@ -0,0 +1,104 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"fmt"
+
+	"cloud.google.com/go/bigquery"
+	pb "github.com/datacommonsorg/mixer/internal/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// queryCost is the BigQuery estimate of a query from a dry run.
+type queryCost struct {
+	TotalBytesProcessed int64
+	ReferencedTables    []string
+}
+
+// estimateQuery runs the SQL query as a BigQuery dry run, which validates the
+// query and estimates its cost without executing it.
+func (s *Server) estimateQuery(ctx context.Context, sql string) (*queryCost, error) {
+	client := s.bigQueryClient()
+	if client == nil {
+		return nil, status.Errorf(
+			codes.FailedPrecondition, "Dry run is only supported on BigQuery")
+	}
+	q := client.Query(sql)
+	q.DryRun = true
+	job, err := q.Run(ctx)
+	if err != nil {
+		return nil, err
+	}
+	jobStatus := job.LastStatus()
+	if err := jobStatus.Err(); err != nil {
+		return nil, err
+	}
+	return toQueryCost(jobStatus.Statistics), nil
+}
+
+// toQueryCost gets the query cost from the dry run job statistics.
+func toQueryCost(stats *bigquery.JobStatistics) *queryCost {
+	cost := &queryCost{ReferencedTables: []string{}}
+	if stats == nil {
+		return cost
+	}
+	cost.TotalBytesProcessed = stats.TotalBytesProcessed
+	if details, ok := stats.Details.(*bigquery.QueryStatistics); ok {
+		for _, t := range details.ReferencedTables {
+			cost.ReferencedTables = append(cost.ReferencedTables,
+				fmt.Sprintf("%s.%s.%s", t.ProjectID, t.DatasetID, t.TableID))
+		}
+	}
+	return cost
+}
+
+// getQueryByteLimit gets the byte limit of a query request. The request limit
+// can only lower the server limit. Zero means no limit.
+func getQueryByteLimit(serverLimit int64, in *pb.QueryRequest) int64 {
+	limit := in.GetMaxBytesProcessed()
+	if limit <= 0 || (serverLimit > 0 && serverLimit < limit) {
+		return serverLimit
+	}
+	return limit
+}
+
+// checkQueryBytes rejects a query estimated to process more bytes than limit.
+func checkQueryBytes(cost *queryCost, limit int64) error {
+	if limit > 0 && cost.TotalBytesProcessed > limit {
+		return status.Errorf(codes.ResourceExhausted,
+			"Query would process %d bytes, which exceeds the limit of %d bytes",
+			cost.TotalBytesProcessed, limit)
+	}
+	return nil
+}
+
+// checkQueryCost estimates the SQL query with a dry run and rejects it when
+// it is above the byte limit of the request. Queries on other databases than
+// BigQuery are not limited.
+func (s *Server) checkQueryCost(
+	ctx context.Context, sql string, in *pb.QueryRequest) error {
+	limit := getQueryByteLimit(s.metadata.MaxQueryBytes, in)
+	if limit <= 0 || s.bigQueryClient() == nil {
+		return nil
+	}
+	cost, err := s.estimateQuery(ctx, sql)
+	if err != nil {
+		return err
+	}
+	return checkQueryBytes(cost, limit)
+}
@@ -0,0 +1,99 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"testing"
+
+	"cloud.google.com/go/bigquery"
+	pb "github.com/datacommonsorg/mixer/internal/proto"
+	"github.com/go-test/deep"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestToQueryCost(t *testing.T) {
+	for _, c := range []struct {
+		stats *bigquery.JobStatistics
+		want  *queryCost
+	}{
+		{
+			nil,
+			&queryCost{ReferencedTables: []string{}},
+		},
+		{
+			&bigquery.JobStatistics{
+				TotalBytesProcessed: 1024,
+				Details: &bigquery.QueryStatistics{
+					ReferencedTables: []*bigquery.Table{
+						{ProjectID: "google.com:datcom-store-dev", DatasetID: "dc_v3", TableID: "Triple"},
+						{ProjectID: "google.com:datcom-store-dev", DatasetID: "dc_v3", TableID: "Place"},
+					},
+				},
+			},
+			&queryCost{
+				TotalBytesProcessed: 1024,
+				ReferencedTables: []string{
+					"google.com:datcom-store-dev.dc_v3.Triple",
+					"google.com:datcom-store-dev.dc_v3.Place",
+				},
+			},
+		},
+	} {
+		got := toQueryCost(c.stats)
+		if diff := deep.Equal(c.want, got); diff != nil {
+			t.Errorf("toQueryCost(%v) unexpected diff %v", c.stats, diff)
+		}
+	}
+}
+
+func TestGetQueryByteLimit(t *testing.T) {
+	for _, c := range []struct {
+		serverLimit  int64
+		requestLimit int64
+		want         int64
+	}{
+		{0, 0, 0},
+		{100, 0, 100},
+		{0, 100, 100},
+		{100, 50, 50},
+		{100, 200, 100},
+		{100, -1, 100},
+	} {
+		got := getQueryByteLimit(
+			c.serverLimit, &pb.QueryRequest{MaxBytesProcessed: c.requestLimit})
+		if got != c.want {
+			t.Errorf("getQueryByteLimit(%d, %d) = %d, want %d",
+				c.serverLimit, c.requestLimit, got, c.want)
+		}
+	}
+}
+
+func TestCheckQueryBytes(t *testing.T) {
+	for _, c := range []struct {
+		bytes int64
+		limit int64
+		want  codes.Code
+	}{
+		{1000, 0, codes.OK},
+		{1000, 1000, codes.OK},
+		{1001, 1000, codes.ResourceExhausted},
+	} {
+		err := checkQueryBytes(&queryCost{TotalBytesProcessed: c.bytes}, c.limit)
+		if got := status.Code(err); got != c.want {
+			t.Errorf("checkQueryBytes(%d, %d) = %v, want %v", c.bytes, c.limit, got, c.want)
+		}
+	}
+}
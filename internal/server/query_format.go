@@ -0,0 +1,246 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"strings"
+
+	"github.com/datacommonsorg/mixer/internal/base"
+	pb "github.com/datacommonsorg/mixer/internal/proto"
+	"github.com/datacommonsorg/mixer/internal/translator"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// IRI prefix of Data Commons nodes in formatted query results.
+const dcNodeIRIPrefix = "https://datacommons.org/browser/"
+
+// Suffix of the extra variable that binds the provenance of a variable.
+const provenanceVarSuffix = "_provenance"
+
+// IRI prefix of the XML Schema datatypes of typed literals.
+const xsdIRIPrefix = "http://www.w3.org/2001/XMLSchema#"
+
+// Media types of the SPARQL query result formats.
+var queryResultContentTypes = map[string]string{
+	"json": "application/sparql-results+json",
+	"csv":  "text/csv",
+	"tsv":  "text/tab-separated-values",
+}
+
+// checkQueryResultFormat checks the format is a supported result format.
+func checkQueryResultFormat(format string) error {
+	if _, ok := queryResultContentTypes[format]; !ok {
+		return status.Errorf(codes.InvalidArgument,
+			"Invalid format %s, should be one of json, csv and tsv", format)
+	}
+	return nil
+}
+
+// checkResultVars checks that no selected variable ends with the
+// provenanceVarSuffix, so that it can not be taken for the provenance of
+// another variable.
+func checkResultVars(nodes []base.Node) error {
+	for _, n := range nodes {
+		if strings.HasSuffix(n.Alias, provenanceVarSuffix) {
+			return status.Errorf(codes.InvalidArgument,
+				"Variable %s ends with %s, which is reserved for provenance in formatted results",
+				n.Alias, provenanceVarSuffix)
+		}
+	}
+	return nil
+}
+
+// resultTerm is an RDF term bound to a variable in the query results.
+type resultTerm struct {
+	Type     string `json:"type"`
+	Value    string `json:"value"`
+	Datatype string `json:"datatype,omitempty"`
+}
+
+// sparqlJSONResult represents the SPARQL 1.1 Query Results JSON format.
+type sparqlJSONResult struct {
+	Head struct {
+		Vars []string `json:"vars"`
+	} `json:"head"`
+	Results struct {
+		Bindings []map[string]*resultTerm `json:"bindings"`
+	} `json:"results"`
+}
+
+// toLiteral converts a cell to a literal, typed by the typed value of the
+// cell. Strings and lists are plain literals.
+func toLiteral(cell *pb.QueryResponseCell) *resultTerm {
+	term := &resultTerm{Type: "literal", Value: cell.GetValue()}
+	switch cell.GetTypedValue().(type) {
+	case *pb.QueryResponseCell_IntValue:
+		term.Datatype = xsdIRIPrefix + "integer"
+	case *pb.QueryResponseCell_DoubleValue:
+		term.Datatype = xsdIRIPrefix + "double"
+	case *pb.QueryResponseCell_BoolValue:
+		term.Datatype = xsdIRIPrefix + "boolean"
+	case *pb.QueryResponseCell_DateValue:
+		switch {
+		case !strings.Contains(term.Value, ":"):
+			term.Datatype = xsdIRIPrefix + "date"
+		case !strings.Contains(term.Value, "-"):
+			term.Datatype = xsdIRIPrefix + "time"
+		default:
+			// DATETIME values have a space between the date and the time.
+			term.Value = strings.Replace(term.Value, " ", "T", 1)
+			term.Datatype = xsdIRIPrefix + "dateTime"
+		}
+	}
+	return term
+}
+
+// toResultBindings converts the query response to result variables and the
+// bindings of each row. The provenance of a variable that has provenance in
+// the translation is bound to an extra variable with the provenanceVarSuffix.
+// Values of entity variables are converted to IRIs. Cells without a typed
+// value are NULL, and their variables are unbound.
+func toResultBindings(out *pb.QueryResponse, translation *translator.Translation) (
+	[]string, []map[string]*resultTerm) {
+	entities := map[string]bool{}
+	for n := range getEntityNodes(translation) {
+		entities[n.Alias] = true
+	}
+	hasProv := map[int]bool{}
+	for _, idx := range translation.Prov {
+		for _, i := range idx {
+			hasProv[i] = true
+		}
+	}
+	vars := []string{}
+	for _, h := range out.GetHeader() {
+		vars = append(vars, strings.TrimPrefix(h, "?"))
+	}
+	provVars := []string{}
+	for i, v := range vars {
+		if hasProv[i] {
+			provVars = append(provVars, v+provenanceVarSuffix)
+		}
+	}
+
+	bindings := []map[string]*resultTerm{}
+	for _, row := range out.GetRows() {
+		binding := map[string]*resultTerm{}
+		for i, cell := range row.GetCells() {
+			if cell.GetTypedValue() != nil {
+				if entities[out.GetHeader()[i]] {
+					binding[vars[i]] = &resultTerm{Type: "uri", Value: dcNodeIRIPrefix + cell.GetValue()}
+				} else {
+					binding[vars[i]] = toLiteral(cell)
+				}
+			}
+			if hasProv[i] && cell.GetProvenanceId() != "" {
+				binding[vars[i]+provenanceVarSuffix] = &resultTerm{
+					Type: "uri", Value: dcNodeIRIPrefix + cell.GetProvenanceId()}
+			}
+		}
+		bindings = append(bindings, binding)
+	}
+	return append(vars, provVars...), bindings
+}
+
+// formatQueryResult converts the query response to a SPARQL query result
+// format. It returns the payload and its media type.
+func formatQueryResult(
+	format string, out *pb.QueryResponse, translation *translator.Translation) (
+	[]byte, string, error) {
+	if err := checkQueryResultFormat(format); err != nil {
+		return nil, "", err
+	}
+	vars, bindings := toResultBindings(out, translation)
+	var payload []byte
+	var err error
+	switch format {
+	case "json":
+		result := sparqlJSONResult{}
+		result.Head.Vars = vars
+		result.Results.Bindings = bindings
+		payload, err = json.Marshal(result)
+	case "csv":
+		payload, err = toCSVResult(vars, bindings)
+	case "tsv":
+		payload = toTSVResult(vars, bindings)
+	}
+	if err != nil {
+		return nil, "", err
+	}
+	return payload, queryResultContentTypes[format], nil
+}
+
+// toCSVResult converts the bindings to the SPARQL 1.1 Query Results CSV
+// format, which has plain IRIs and literals.
+func toCSVResult(vars []string, bindings []map[string]*resultTerm) ([]byte, error) {
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+	w.UseCRLF = true
+	if err := w.Write(vars); err != nil {
+		return nil, err
+	}
+	for _, binding := range bindings {
+		record := make([]string, len(vars))
+		for i, v := range vars {
+			if term, ok := binding[v]; ok {
+				record[i] = term.Value
+			}
+		}
+		if err := w.Write(record); err != nil {
+			return nil, err
+		}
+	}
+	w.Flush()
+	return buf.Bytes(), w.Error()
+}
+
+// tsvLiteralReplacer escapes the literals in the TSV format.
+var tsvLiteralReplacer = strings.NewReplacer(
+	`\`, `\\`, `"`, `\"`, "\t", `\t`, "\n", `\n`, "\r", `\r`)
+
+// toTSVResult converts the bindings to the SPARQL 1.1 Query Results TSV
+// format, which has IRIs enclosed in angle brackets and quoted literals, with
+// the datatype IRI of typed literals.
+func toTSVResult(vars []string, bindings []map[string]*resultTerm) []byte {
+	var buf bytes.Buffer
+	header := []string{}
+	for _, v := range vars {
+		header = append(header, "?"+v)
+	}
+	buf.WriteString(strings.Join(header, "\t") + "\n")
+	for _, binding := range bindings {
+		record := make([]string, len(vars))
+		for i, v := range vars {
+			term, ok := binding[v]
+			if !ok {
+				continue
+			}
+			if term.Type == "uri" {
+				record[i] = "<" + term.Value + ">"
+			} else {
+				record[i] = `"` + tsvLiteralReplacer.Replace(term.Value) + `"`
+				if term.Datatype != "" {
+					record[i] += "^^<" + term.Datatype + ">"
+				}
+			}
+		}
+		buf.WriteString(strings.Join(record, "\t") + "\n")
+	}
+	return buf.Bytes()
+}
@@ -0,0 +1,169 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"testing"
+
+	"github.com/datacommonsorg/mixer/internal/base"
+	pb "github.com/datacommonsorg/mixer/internal/proto"
+	"github.com/datacommonsorg/mixer/internal/translator"
+	"github.com/go-test/deep"
+)
+
+func TestFormatQueryResult(t *testing.T) {
+	out := &pb.QueryResponse{
+		Header: []string{"?place", "?name", "?pop"},
+		Rows: []*pb.QueryResponseRow{
+			{Cells: []*pb.QueryResponseCell{
+				{
+					Value:        "geoId/06",
+					ProvenanceId: "dc/p1",
+					TypedValue:   &pb.QueryResponseCell_StringValue{StringValue: "geoId/06"},
+				},
+				{
+					Value:      "California, \"CA\"",
+					TypedValue: &pb.QueryResponseCell_StringValue{StringValue: "California, \"CA\""},
+				},
+				{Value: "39512223", TypedValue: &pb.QueryResponseCell_IntValue{IntValue: 39512223}},
+			}},
+			{Cells: []*pb.QueryResponseCell{
+				{Value: "geoId/08", TypedValue: &pb.QueryResponseCell_StringValue{StringValue: "geoId/08"}},
+				// A bound empty string.
+				{Value: "", TypedValue: &pb.QueryResponseCell_StringValue{StringValue: ""}},
+				// Unbound.
+				{Value: "", ProvenanceId: "dc/p2"},
+			}},
+		},
+	}
+	place := base.NewNode("?place")
+	name := base.NewNode("?name")
+	pop := base.NewNode("?pop")
+	translation := &translator.Translation{
+		Nodes: []base.Node{place, name, pop},
+		Bindings: []translator.Binding{
+			{
+				Query:   base.NewQuery("name", "?place", name),
+				Mapping: &base.Mapping{Pred: "name", Obj: base.Column{Name: "name"}},
+			},
+		},
+		Prov: map[int][]int{3: {0}},
+	}
+	for _, c := range []struct {
+		format          string
+		wantPayload     string
+		wantContentType string
+	}{
+		{
+			"json",
+			`{"head":{"vars":["place","name","pop","place_provenance"]},"results":{"bindings":[` +
+				`{"name":{"type":"literal","value":"California, \"CA\""},` +
+				`"place":{"type":"uri","value":"https://datacommons.org/browser/geoId/06"},` +
+				`"place_provenance":{"type":"uri","value":"https://datacommons.org/browser/dc/p1"},` +
+				`"pop":{"type":"literal","value":"39512223","datatype":"http://www.w3.org/2001/XMLSchema#integer"}},` +
+				`{"name":{"type":"literal","value":""},` +
+				`"place":{"type":"uri","value":"https://datacommons.org/browser/geoId/08"}}]}}`,
+			"application/sparql-results+json",
+		},
+		{
+			"csv",
+			"place,name,pop,place_provenance\r\n" +
+				"https://datacommons.org/browser/geoId/06,\"California, \"\"CA\"\"\",39512223,https://datacommons.org/browser/dc/p1\r\n" +
+				"https://datacommons.org/browser/geoId/08,,,\r\n",
+			"text/csv",
+		},
+		{
+			"tsv",
+			"?place\t?name\t?pop\t?place_provenance\n" +
+				"<https://datacommons.org/browser/geoId/06>\t\"California, \\\"CA\\\"\"\t\"39512223\"^^<http://www.w3.org/2001/XMLSchema#integer>\t<https://datacommons.org/browser/dc/p1>\n" +
+				"<https://datacommons.org/browser/geoId/08>\t\"\"\t\t\n",
+			"text/tab-separated-values",
+		},
+	} {
+		payload, contentType, err := formatQueryResult(c.format, out, translation)
+		if err != nil {
+			t.Errorf("formatQueryResult(%s) = %s", c.format, err)
+			continue
+		}
+		if diff := deep.Equal(c.wantPayload, string(payload)); diff != nil {
+			t.Errorf("formatQueryResult(%s) unexpected payload diff %v", c.format, diff)
+		}
+		if diff := deep.Equal(c.wantContentType, contentType); diff != nil {
+			t.Errorf("formatQueryResult(%s) unexpected content type diff %v", c.format, diff)
+		}
+	}
+
+	if _, _, err := formatQueryResult("xml", out, translation); err == nil {
+		t.Errorf("formatQueryResult(xml) = nil, want error")
+	}
+}
+
+func TestToLiteral(t *testing.T) {
+	for _, c := range []struct {
+		cell *pb.QueryResponseCell
+		want *resultTerm
+	}{
+		{
+			&pb.QueryResponseCell{Value: "abc", TypedValue: &pb.QueryResponseCell_StringValue{StringValue: "abc"}},
+			&resultTerm{Type: "literal", Value: "abc"},
+		},
+		{
+			&pb.QueryResponseCell{Value: "1.5", TypedValue: &pb.QueryResponseCell_DoubleValue{DoubleValue: 1.5}},
+			&resultTerm{Type: "literal", Value: "1.5", Datatype: xsdIRIPrefix + "double"},
+		},
+		{
+			&pb.QueryResponseCell{Value: "true", TypedValue: &pb.QueryResponseCell_BoolValue{BoolValue: true}},
+			&resultTerm{Type: "literal", Value: "true", Datatype: xsdIRIPrefix + "boolean"},
+		},
+		{
+			&pb.QueryResponseCell{Value: "2020-01-02", TypedValue: &pb.QueryResponseCell_DateValue{DateValue: "2020-01-02"}},
+			&resultTerm{Type: "literal", Value: "2020-01-02", Datatype: xsdIRIPrefix + "date"},
+		},
+		{
+			&pb.QueryResponseCell{Value: "10:30:00", TypedValue: &pb.QueryResponseCell_DateValue{DateValue: "10:30:00"}},
+			&resultTerm{Type: "literal", Value: "10:30:00", Datatype: xsdIRIPrefix + "time"},
+		},
+		{
+			&pb.QueryResponseCell{Value: "2020-01-02 10:30:00", TypedValue: &pb.QueryResponseCell_DateValue{DateValue: "2020-01-02 10:30:00"}},
+			&resultTerm{Type: "literal", Value: "2020-01-02T10:30:00", Datatype: xsdIRIPrefix + "dateTime"},
+		},
+		{
+			&pb.QueryResponseCell{Value: "2020-01-02T10:30:00Z", TypedValue: &pb.QueryResponseCell_DateValue{DateValue: "2020-01-02T10:30:00Z"}},
+			&resultTerm{Type: "literal", Value: "2020-01-02T10:30:00Z", Datatype: xsdIRIPrefix + "dateTime"},
+		},
+	} {
+		if diff := deep.Equal(c.want, toLiteral(c.cell)); diff != nil {
+			t.Errorf("toLiteral(%s) unexpected diff %v", c.cell.GetValue(), diff)
+		}
+	}
+}
+
+func TestCheckResultVars(t *testing.T) {
+	for _, c := range []struct {
+		vars    []string
+		wantErr bool
+	}{
+		{[]string{"?place", "?provenance"}, false},
+		{[]string{"?place", "?place_provenance"}, true},
+	} {
+		nodes := []base.Node{}
+		for _, v := range c.vars {
+			nodes = append(nodes, base.NewNode(v))
+		}
+		if err := checkResultVars(nodes); (err != nil) != c.wantErr {
+			t.Errorf("checkResultVars(%v) = %v, want error %t", c.vars, err, c.wantErr)
+		}
+	}
+}
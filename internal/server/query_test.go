@@ -0,0 +1,196 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"database/sql"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/datacommonsorg/mixer/internal/base"
+	pb "github.com/datacommonsorg/mixer/internal/proto"
+	"github.com/datacommonsorg/mixer/internal/store"
+	"github.com/datacommonsorg/mixer/internal/translator"
+	"github.com/go-test/deep"
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/testing/protocmp"
+	_ "modernc.org/sqlite"
+)
+
+func TestQueryPageToken(t *testing.T) {
+	token := &queryPageToken{
+		JobID:     "job_abc",
+		Location:  "US",
+		PageToken: "BFXYZ",
+	}
+	encoded, err := encodeQueryPageToken(token)
+	if err != nil {
+		t.Fatalf("encodeQueryPageToken() = %s", err)
+	}
+	got, err := decodeQueryPageToken(encoded)
+	if err != nil {
+		t.Fatalf("decodeQueryPageToken(%s) = %s", encoded, err)
+	}
+	if diff := deep.Equal(token, got); diff != nil {
+		t.Errorf("decodeQueryPageToken() unexpected diff %v", diff)
+	}
+
+	for _, s := range []string{"not-base64!", "e30"} {
+		if _, err := decodeQueryPageToken(s); err == nil {
+			t.Errorf("decodeQueryPageToken(%s) = nil, want error", s)
+		}
+	}
+}
+
+func TestQueryStreamOptions(t *testing.T) {
+	s := NewServer(nil, nil, nil, &Metadata{}, nil)
+	for _, in := range []*pb.QueryRequest{
+		{DryRun: true},
+		{Format: "json"},
+		{PageSize: 10},
+		{PageToken: "e30"},
+	} {
+		if err := s.QueryStream(in, nil); status.Code(err) != codes.InvalidArgument {
+			t.Errorf("QueryStream(%v) = %v, want InvalidArgument", in, err)
+		}
+	}
+}
+
+func TestGetPageOrderSQL(t *testing.T) {
+	translation := &translator.Translation{
+		Nodes: []base.Node{base.NewNode("?dcid"), base.NewNode("?name")},
+		Prov:  map[int][]int{2: {0, 1}},
+	}
+	for _, c := range []struct {
+		orderby []*base.Orderby
+		want    string
+		code    codes.Code
+	}{
+		{
+			nil,
+			" ORDER BY 1 ASC, 2 ASC, 3 ASC",
+			codes.OK,
+		},
+		{
+			[]*base.Orderby{{Node: base.NewNode("?name"), ASC: false}},
+			" ORDER BY 2 DESC, 1 ASC, 3 ASC",
+			codes.OK,
+		},
+		{
+			[]*base.Orderby{{Node: base.NewNode("?date"), ASC: true}},
+			"",
+			codes.InvalidArgument,
+		},
+	} {
+		got, err := getPageOrderSQL(translation, c.orderby)
+		if status.Code(err) != c.code {
+			t.Errorf("getPageOrderSQL(%v) = %v, want %s", c.orderby, err, c.code)
+			continue
+		}
+		if got != c.want {
+			t.Errorf("getPageOrderSQL(%v) = %s, want %s", c.orderby, got, c.want)
+		}
+	}
+}
+
+func TestQuerySQLite(t *testing.T) {
+	dir, err := ioutil.TempDir("", "mapping")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	if err := ioutil.WriteFile(
+		filepath.Join(dir, "place.mcf"), []byte(testPlaceMapping), 0644); err != nil {
+		t.Fatal(err)
+	}
+	db, err := sql.Open("sqlite", ":memory:")
+	if err != nil {
+		t.Fatalf("sql.Open() = %s", err)
+	}
+	defer db.Close()
+	// An in-memory database only lives as long as its connection.
+	db.SetMaxOpenConns(1)
+	for _, stmt := range []string{
+		`CREATE TABLE Place (id TEXT, name TEXT)`,
+		`INSERT INTO Place VALUES ('geoId/06', 'California'), ('geoId/08', 'Colorado')`,
+	} {
+		if _, err := db.Exec(stmt); err != nil {
+			t.Fatalf("Exec(%s) = %s", stmt, err)
+		}
+	}
+	metadata, err := NewMetadata("", "", "", dir)
+	if err != nil {
+		t.Fatalf("NewMetadata() = %s", err)
+	}
+	s := NewServer(nil, nil, nil, metadata, nil)
+	if err := s.SetQueryExecutor(store.NewSQLExecutor(db, base.SQLite)); err != nil {
+		t.Fatalf("SetQueryExecutor() = %s", err)
+	}
+	got, err := s.Query(context.Background(), &pb.QueryRequest{
+		Sparql: `SELECT ?dcid ?name
+			WHERE { ?p typeOf Place . ?p dcid ?dcid . ?p name ?name }
+			ORDER BY ?name`,
+	})
+	if err != nil {
+		t.Fatalf("Query() = %s", err)
+	}
+	want := &pb.QueryResponse{
+		Header: []string{"?dcid", "?name"},
+		Rows: []*pb.QueryResponseRow{
+			{Cells: []*pb.QueryResponseCell{
+				{Value: "geoId/06", TypedValue: &pb.QueryResponseCell_StringValue{StringValue: "geoId/06"}},
+				{Value: "California", TypedValue: &pb.QueryResponseCell_StringValue{StringValue: "California"}},
+			}},
+			{Cells: []*pb.QueryResponseCell{
+				{Value: "geoId/08", TypedValue: &pb.QueryResponseCell_StringValue{StringValue: "geoId/08"}},
+				{Value: "Colorado", TypedValue: &pb.QueryResponseCell_StringValue{StringValue: "Colorado"}},
+			}},
+		},
+	}
+	if diff := cmp.Diff(got, want, protocmp.Transform()); diff != "" {
+		t.Errorf("Query() got diff %v", diff)
+	}
+
+	// Pages follow the ORDER BY of the query.
+	pages := []string{}
+	pageToken := ""
+	for {
+		page, err := s.Query(context.Background(), &pb.QueryRequest{
+			Sparql: `SELECT ?name
+				WHERE { ?p typeOf Place . ?p name ?name }
+				ORDER BY DESC(?name)`,
+			PageSize:  1,
+			PageToken: pageToken,
+		})
+		if err != nil {
+			t.Fatalf("Query(%s) = %s", pageToken, err)
+		}
+		for _, row := range page.GetRows() {
+			pages = append(pages, row.GetCells()[0].GetValue())
+		}
+		pageToken = page.GetNextPageToken()
+		if pageToken == "" {
+			break
+		}
+	}
+	if diff := deep.Equal([]string{"Colorado", "California"}, pages); diff != nil {
+		t.Errorf("Query() pages unexpected diff %v", diff)
+	}
+}
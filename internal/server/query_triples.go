@@ -0,0 +1,238 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/datacommonsorg/mixer/internal/base"
+	pb "github.com/datacommonsorg/mixer/internal/proto"
+	"github.com/datacommonsorg/mixer/internal/translator"
+	"github.com/datacommonsorg/mixer/internal/util"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// maxDescribeNodes is the maximum number of nodes a DESCRIBE query can
+// describe.
+const maxDescribeNodes = 500
+
+// queryTriples gets the result triples of a CONSTRUCT or DESCRIBE query. The
+// byte limit of the request is checked with checkCost.
+func (s *Server) queryTriples(
+	ctx context.Context, nodes []base.Node, queries []*base.Query,
+	opts *base.QueryOptions, in *pb.QueryRequest, checkCost bool) (
+	[]*pb.Triple, error) {
+	var translation *translator.Translation
+	// A query without graph pattern has one solution with no binding.
+	rows := []*pb.QueryResponseRow{{}}
+	if len(queries) > 0 {
+		var err error
+		translation, err = s.translateQuery(nodes, queries, opts)
+		if err != nil {
+			return nil, err
+		}
+		if checkCost {
+			if err := s.checkQueryCost(ctx, translation.SQL, in); err != nil {
+				return nil, err
+			}
+		}
+		rows, err = s.readQueryRows(ctx, translation)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if opts.Form == base.Construct {
+		return constructTriples(opts.Template, translation, rows), nil
+	}
+	return s.describeTriples(ctx, opts.Describe, translation, rows)
+}
+
+// getEntityNodes gets the nodes that are bound to graph nodes rather than
+// values, which are the subjects and the objects bound to entities.
+func getEntityNodes(translation *translator.Translation) map[base.Node]struct{} {
+	result := map[base.Node]struct{}{}
+	if translation == nil {
+		return result
+	}
+	for _, b := range translation.Bindings {
+		result[b.Query.Sub] = struct{}{}
+		if n, ok := b.Query.Obj.(base.Node); ok {
+			if _, ok := b.Mapping.Obj.(base.Entity); ok {
+				result[n] = struct{}{}
+			}
+		}
+	}
+	return result
+}
+
+// constructTriples instantiates the CONSTRUCT template with each result row.
+// Template triples with unbound variable are skipped, and duplicate triples
+// are removed.
+func constructTriples(
+	template []*base.Query, translation *translator.Translation,
+	rows []*pb.QueryResponseRow) []*pb.Triple {
+	nodeIndex := map[string]int{}
+	if translation != nil {
+		for i, n := range translation.Nodes {
+			nodeIndex[n.Alias] = i
+		}
+	}
+	entities := getEntityNodes(translation)
+	for _, q := range template {
+		entities[q.Sub] = struct{}{}
+	}
+	// getCell gets the cell bound to a template term, or nil if not bound.
+	getCell := func(row *pb.QueryResponseRow, term string) *pb.QueryResponseCell {
+		if !strings.HasPrefix(term, "?") {
+			return &pb.QueryResponseCell{Value: term}
+		}
+		i, ok := nodeIndex[term]
+		if !ok || i >= len(row.GetCells()) || row.GetCells()[i].GetValue() == "" {
+			return nil
+		}
+		return row.GetCells()[i]
+	}
+
+	result := []*pb.Triple{}
+	seen := map[string]struct{}{}
+	for _, row := range rows {
+		for _, q := range template {
+			sub := getCell(row, q.Sub.Alias)
+			if sub == nil {
+				continue
+			}
+			objs := []interface{}{}
+			switch v := q.Obj.(type) {
+			case []string:
+				for _, o := range v {
+					objs = append(objs, o)
+				}
+			default:
+				objs = append(objs, v)
+			}
+			for _, o := range objs {
+				triple := &pb.Triple{
+					SubjectId:    sub.GetValue(),
+					Predicate:    q.Pred,
+					ProvenanceId: sub.GetProvenanceId(),
+				}
+				switch v := o.(type) {
+				case base.Node:
+					obj := getCell(row, v.Alias)
+					if obj == nil {
+						continue
+					}
+					if _, ok := entities[v]; ok {
+						triple.ObjectId = obj.GetValue()
+					} else {
+						triple.ObjectValue = obj.GetValue()
+					}
+					if obj.GetProvenanceId() != "" {
+						triple.ProvenanceId = obj.GetProvenanceId()
+					}
+				case string:
+					if strings.HasPrefix(v, `"`) {
+						triple.ObjectValue = strings.Trim(v, `"`)
+					} else {
+						triple.ObjectId = v
+					}
+				}
+				key := fmt.Sprintf("%s^%s^%s^%s",
+					triple.SubjectId, triple.Predicate, triple.ObjectId, triple.ObjectValue)
+				if _, ok := seen[key]; ok {
+					continue
+				}
+				seen[key] = struct{}{}
+				result = append(result, triple)
+			}
+		}
+	}
+	return result
+}
+
+// getDescribeDcids gets the dcids of the described nodes, which are the given
+// dcids and the values of the selected nodes that are bound to entities.
+func getDescribeDcids(
+	describe []string, translation *translator.Translation,
+	rows []*pb.QueryResponseRow) ([]string, error) {
+	dcids := []string{}
+	seen := map[string]struct{}{}
+	addDcid := func(dcid string) {
+		if _, ok := seen[dcid]; ok || dcid == "" {
+			return
+		}
+		seen[dcid] = struct{}{}
+		dcids = append(dcids, dcid)
+	}
+	for _, dcid := range describe {
+		addDcid(dcid)
+	}
+	entities := getEntityNodes(translation)
+	for _, row := range rows {
+		for i, cell := range row.GetCells() {
+			if i >= len(translation.Nodes) {
+				break
+			}
+			if _, ok := entities[translation.Nodes[i]]; ok {
+				addDcid(cell.GetValue())
+			}
+		}
+	}
+	if len(dcids) > maxDescribeNodes {
+		return nil, status.Errorf(codes.InvalidArgument,
+			"DESCRIBE query matches more than %d nodes", maxDescribeNodes)
+	}
+	if !util.CheckValidDCIDs(dcids) {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid DCIDs")
+	}
+	return dcids, nil
+}
+
+// describeTriples gets the triples of the described nodes.
+func (s *Server) describeTriples(
+	ctx context.Context, describe []string, translation *translator.Translation,
+	rows []*pb.QueryResponseRow) ([]*pb.Triple, error) {
+	dcids, err := getDescribeDcids(describe, translation, rows)
+	if err != nil {
+		return nil, err
+	}
+	result := []*pb.Triple{}
+	if len(dcids) == 0 {
+		return result, nil
+	}
+	triplesMap, err := s.getTriples(ctx, dcids, 0)
+	if err != nil {
+		return nil, err
+	}
+	for _, dcid := range dcids {
+		for _, t := range triplesMap[dcid] {
+			result = append(result, &pb.Triple{
+				SubjectId:    t.SubjectID,
+				SubjectName:  t.SubjectName,
+				SubjectTypes: t.SubjectTypes,
+				Predicate:    t.Predicate,
+				ObjectId:     t.ObjectID,
+				ObjectName:   t.ObjectName,
+				ObjectValue:  t.ObjectValue,
+				ObjectTypes:  t.ObjectTypes,
+				ProvenanceId: t.ProvenanceID,
+			})
+		}
+	}
+	return result, nil
+}
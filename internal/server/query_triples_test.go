@@ -0,0 +1,129 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/datacommonsorg/mixer/internal/base"
+	pb "github.com/datacommonsorg/mixer/internal/proto"
+	"github.com/datacommonsorg/mixer/internal/translator"
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/testing/protocmp"
+)
+
+func TestConstructTriples(t *testing.T) {
+	place := base.NewNode("?place")
+	parent := base.NewNode("?parent")
+	name := base.NewNode("?name")
+	parentEntity, err := base.NewEntity("E:Place->E2", "dc_v3")
+	if err != nil {
+		t.Fatalf("NewEntity() = %s", err)
+	}
+	containedIn := base.NewQuery("containedInPlace", "?place", parent)
+	translation := &translator.Translation{
+		Nodes: []base.Node{place, parent, name},
+		Bindings: []translator.Binding{
+			{
+				Query:   containedIn,
+				Mapping: &base.Mapping{Pred: "containedInPlace", Obj: *parentEntity},
+			},
+		},
+	}
+	template := []*base.Query{
+		containedIn,
+		base.NewQuery("name", "?place", name),
+		base.NewQuery("source", "?place", `"census"`),
+	}
+	rows := []*pb.QueryResponseRow{
+		{Cells: []*pb.QueryResponseCell{
+			{Value: "geoId/06085"},
+			{Value: "geoId/06", ProvenanceId: "dc/p1"},
+			{Value: "Santa Clara County"},
+		}},
+		{Cells: []*pb.QueryResponseCell{
+			{Value: "geoId/06085"},
+			{Value: "geoId/06", ProvenanceId: "dc/p1"},
+			{Value: ""},
+		}},
+	}
+	got := constructTriples(template, translation, rows)
+	want := []*pb.Triple{
+		{
+			SubjectId:    "geoId/06085",
+			Predicate:    "containedInPlace",
+			ObjectId:     "geoId/06",
+			ProvenanceId: "dc/p1",
+		},
+		{
+			SubjectId:   "geoId/06085",
+			Predicate:   "name",
+			ObjectValue: "Santa Clara County",
+		},
+		{
+			SubjectId:   "geoId/06085",
+			Predicate:   "source",
+			ObjectValue: "census",
+		},
+	}
+	if diff := cmp.Diff(got, want, protocmp.Transform()); diff != "" {
+		t.Errorf("constructTriples() got diff %v", diff)
+	}
+}
+
+func TestGetDescribeDcids(t *testing.T) {
+	place := base.NewNode("?place")
+	name := base.NewNode("?name")
+	translation := &translator.Translation{
+		Nodes: []base.Node{place, name},
+		Bindings: []translator.Binding{
+			{
+				Query:   base.NewQuery("name", "?place", name),
+				Mapping: &base.Mapping{Pred: "name", Obj: base.Column{Name: "name"}},
+			},
+		},
+	}
+	rows := []*pb.QueryResponseRow{
+		{Cells: []*pb.QueryResponseCell{
+			{Value: "geoId/06085"},
+			{Value: "Santa Clara County"},
+		}},
+		{Cells: []*pb.QueryResponseCell{
+			{Value: "geoId/06"},
+			{Value: "California"},
+		}},
+	}
+	got, err := getDescribeDcids([]string{"geoId/06"}, translation, rows)
+	if err != nil {
+		t.Fatalf("getDescribeDcids() = %s", err)
+	}
+	want := []string{"geoId/06", "geoId/06085"}
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("getDescribeDcids() got diff %v", diff)
+	}
+
+	tooMany := []*pb.QueryResponseRow{}
+	for i := 0; i <= maxDescribeNodes; i++ {
+		tooMany = append(tooMany, &pb.QueryResponseRow{
+			Cells: []*pb.QueryResponseCell{{Value: fmt.Sprintf("geoId/%d", i)}},
+		})
+	}
+	if _, err := getDescribeDcids(nil, translation, tooMany); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("getDescribeDcids() got error %v, want InvalidArgument", err)
+	}
+}
@@ -0,0 +1,130 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strconv"
+	"time"
+
+	"cloud.google.com/go/bigquery"
+	"cloud.google.com/go/civil"
+	pb "github.com/datacommonsorg/mixer/internal/proto"
+)
+
+// toQueryResponseCell converts a BigQuery value to a cell with both the string
+// value and the typed value. The field schema is used for the column type and
+// can be nil, in which case the type is taken from the value.
+func toQueryResponseCell(
+	v bigquery.Value, field *bigquery.FieldSchema) *pb.QueryResponseCell {
+	if v == nil {
+		return &pb.QueryResponseCell{}
+	}
+	if field != nil && field.Repeated {
+		elem := *field
+		elem.Repeated = false
+		return toListCell(v, func(int) *bigquery.FieldSchema { return &elem })
+	}
+	if field != nil && field.Type == bigquery.RecordFieldType {
+		return toListCell(v, func(i int) *bigquery.FieldSchema {
+			if i < len(field.Schema) {
+				return field.Schema[i]
+			}
+			return nil
+		})
+	}
+	switch x := v.(type) {
+	case int64:
+		return &pb.QueryResponseCell{
+			Value:      strconv.FormatInt(x, 10),
+			TypedValue: &pb.QueryResponseCell_IntValue{IntValue: x},
+		}
+	case float64:
+		return &pb.QueryResponseCell{
+			Value:      fmt.Sprintf("%v", x),
+			TypedValue: &pb.QueryResponseCell_DoubleValue{DoubleValue: x},
+		}
+	case *big.Rat:
+		str := bigquery.NumericString(x)
+		if field != nil && field.Type == bigquery.BigNumericFieldType {
+			str = bigquery.BigNumericString(x)
+		}
+		f, _ := x.Float64()
+		return &pb.QueryResponseCell{
+			Value:      str,
+			TypedValue: &pb.QueryResponseCell_DoubleValue{DoubleValue: f},
+		}
+	case bool:
+		return &pb.QueryResponseCell{
+			Value:      strconv.FormatBool(x),
+			TypedValue: &pb.QueryResponseCell_BoolValue{BoolValue: x},
+		}
+	case civil.Date:
+		return toDateCell(x.String())
+	case civil.DateTime:
+		return toDateCell(bigquery.CivilDateTimeString(x))
+	case civil.Time:
+		return toDateCell(bigquery.CivilTimeString(x))
+	case time.Time:
+		return toDateCell(x.UTC().Format(time.RFC3339Nano))
+	case string:
+		return toStringCell(x)
+	case []byte:
+		return toStringCell(base64.StdEncoding.EncodeToString(x))
+	case []bigquery.Value:
+		return toListCell(x, func(int) *bigquery.FieldSchema { return nil })
+	default:
+		return toStringCell(fmt.Sprintf("%v", x))
+	}
+}
+
+func toDateCell(s string) *pb.QueryResponseCell {
+	return &pb.QueryResponseCell{
+		Value:      s,
+		TypedValue: &pb.QueryResponseCell_DateValue{DateValue: s},
+	}
+}
+
+func toStringCell(s string) *pb.QueryResponseCell {
+	return &pb.QueryResponseCell{
+		Value:      s,
+		TypedValue: &pb.QueryResponseCell_StringValue{StringValue: s},
+	}
+}
+
+// toListCell converts a REPEATED or RECORD value to a list cell. The string
+// value is the JSON array of the element string values.
+func toListCell(
+	v bigquery.Value, elemField func(int) *bigquery.FieldSchema) *pb.QueryResponseCell {
+	values, ok := v.([]bigquery.Value)
+	if !ok {
+		return toQueryResponseCell(v, nil)
+	}
+	list := &pb.QueryResponseCellList{}
+	strs := []string{}
+	for i, elem := range values {
+		cell := toQueryResponseCell(elem, elemField(i))
+		list.Cells = append(list.Cells, cell)
+		strs = append(strs, cell.Value)
+	}
+	b, _ := json.Marshal(strs)
+	return &pb.QueryResponseCell{
+		Value:      string(b),
+		TypedValue: &pb.QueryResponseCell_ListValue{ListValue: list},
+	}
+}
@@ -0,0 +1,193 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"cloud.google.com/go/bigquery"
+	"cloud.google.com/go/civil"
+	"github.com/datacommonsorg/mixer/internal/base"
+	pb "github.com/datacommonsorg/mixer/internal/proto"
+	"github.com/datacommonsorg/mixer/internal/translator"
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/protobuf/testing/protocmp"
+)
+
+func TestToQueryResponseCell(t *testing.T) {
+	for _, c := range []struct {
+		value bigquery.Value
+		field *bigquery.FieldSchema
+		want  *pb.QueryResponseCell
+	}{
+		{
+			nil,
+			&bigquery.FieldSchema{Type: bigquery.IntegerFieldType},
+			&pb.QueryResponseCell{},
+		},
+		{
+			int64(42),
+			&bigquery.FieldSchema{Type: bigquery.IntegerFieldType},
+			&pb.QueryResponseCell{
+				Value:      "42",
+				TypedValue: &pb.QueryResponseCell_IntValue{IntValue: 42},
+			},
+		},
+		{
+			1.5,
+			&bigquery.FieldSchema{Type: bigquery.FloatFieldType},
+			&pb.QueryResponseCell{
+				Value:      "1.5",
+				TypedValue: &pb.QueryResponseCell_DoubleValue{DoubleValue: 1.5},
+			},
+		},
+		{
+			big.NewRat(1, 4),
+			&bigquery.FieldSchema{Type: bigquery.NumericFieldType},
+			&pb.QueryResponseCell{
+				Value:      "0.250000000",
+				TypedValue: &pb.QueryResponseCell_DoubleValue{DoubleValue: 0.25},
+			},
+		},
+		{
+			true,
+			&bigquery.FieldSchema{Type: bigquery.BooleanFieldType},
+			&pb.QueryResponseCell{
+				Value:      "true",
+				TypedValue: &pb.QueryResponseCell_BoolValue{BoolValue: true},
+			},
+		},
+		{
+			civil.Date{Year: 2020, Month: 3, Day: 1},
+			&bigquery.FieldSchema{Type: bigquery.DateFieldType},
+			&pb.QueryResponseCell{
+				Value:      "2020-03-01",
+				TypedValue: &pb.QueryResponseCell_DateValue{DateValue: "2020-03-01"},
+			},
+		},
+		{
+			time.Date(2020, 3, 1, 12, 30, 0, 0, time.UTC),
+			&bigquery.FieldSchema{Type: bigquery.TimestampFieldType},
+			&pb.QueryResponseCell{
+				Value:      "2020-03-01T12:30:00Z",
+				TypedValue: &pb.QueryResponseCell_DateValue{DateValue: "2020-03-01T12:30:00Z"},
+			},
+		},
+		{
+			"geoId/06",
+			&bigquery.FieldSchema{Type: bigquery.StringFieldType},
+			&pb.QueryResponseCell{
+				Value:      "geoId/06",
+				TypedValue: &pb.QueryResponseCell_StringValue{StringValue: "geoId/06"},
+			},
+		},
+		{
+			[]byte("dc"),
+			&bigquery.FieldSchema{Type: bigquery.BytesFieldType},
+			&pb.QueryResponseCell{
+				Value:      "ZGM=",
+				TypedValue: &pb.QueryResponseCell_StringValue{StringValue: "ZGM="},
+			},
+		},
+		{
+			[]bigquery.Value{int64(1), int64(2)},
+			&bigquery.FieldSchema{Type: bigquery.IntegerFieldType, Repeated: true},
+			&pb.QueryResponseCell{
+				Value: `["1","2"]`,
+				TypedValue: &pb.QueryResponseCell_ListValue{
+					ListValue: &pb.QueryResponseCellList{
+						Cells: []*pb.QueryResponseCell{
+							{Value: "1", TypedValue: &pb.QueryResponseCell_IntValue{IntValue: 1}},
+							{Value: "2", TypedValue: &pb.QueryResponseCell_IntValue{IntValue: 2}},
+						},
+					},
+				},
+			},
+		},
+		{
+			[]bigquery.Value{"Count_Person", true},
+			&bigquery.FieldSchema{
+				Type: bigquery.RecordFieldType,
+				Schema: bigquery.Schema{
+					{Name: "id", Type: bigquery.StringFieldType},
+					{Name: "valid", Type: bigquery.BooleanFieldType},
+				},
+			},
+			&pb.QueryResponseCell{
+				Value: `["Count_Person","true"]`,
+				TypedValue: &pb.QueryResponseCell_ListValue{
+					ListValue: &pb.QueryResponseCellList{
+						Cells: []*pb.QueryResponseCell{
+							{
+								Value:      "Count_Person",
+								TypedValue: &pb.QueryResponseCell_StringValue{StringValue: "Count_Person"},
+							},
+							{
+								Value:      "true",
+								TypedValue: &pb.QueryResponseCell_BoolValue{BoolValue: true},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			int64(7),
+			nil,
+			&pb.QueryResponseCell{
+				Value:      "7",
+				TypedValue: &pb.QueryResponseCell_IntValue{IntValue: 7},
+			},
+		},
+	} {
+		got := toQueryResponseCell(c.value, c.field)
+		if diff := cmp.Diff(got, c.want, protocmp.Transform()); diff != "" {
+			t.Errorf("toQueryResponseCell(%v) got diff %v", c.value, diff)
+		}
+	}
+}
+
+func TestToQueryResponseRow(t *testing.T) {
+	translation := &translator.Translation{
+		Nodes: []base.Node{base.NewNode("?name"), base.NewNode("?count")},
+		Prov:  map[int][]int{2: {0, 1}},
+	}
+	schema := bigquery.Schema{
+		{Name: "name", Type: bigquery.StringFieldType},
+		{Name: "count", Type: bigquery.IntegerFieldType},
+		{Name: "prov", Type: bigquery.StringFieldType},
+	}
+	got := toQueryResponseRow(
+		[]bigquery.Value{"California", int64(39512223), "dc/5l5zxr1"}, schema, translation)
+	want := &pb.QueryResponseRow{
+		Cells: []*pb.QueryResponseCell{
+			{
+				Value:        "California",
+				ProvenanceId: "dc/5l5zxr1",
+				TypedValue:   &pb.QueryResponseCell_StringValue{StringValue: "California"},
+			},
+			{
+				Value:        "39512223",
+				ProvenanceId: "dc/5l5zxr1",
+				TypedValue:   &pb.QueryResponseCell_IntValue{IntValue: 39512223},
+			},
+		},
+	}
+	if diff := cmp.Diff(got, want, protocmp.Transform()); diff != "" {
+		t.Errorf("toQueryResponseRow() got diff %v", diff)
+	}
+}
@@ -21,33 +21,52 @@ import (
 
 	"cloud.google.com/go/bigquery"
 
+	"github.com/datacommonsorg/mixer/internal/base"
 	pb "github.com/datacommonsorg/mixer/internal/proto"
 
 	"google.golang.org/api/iterator"
 )
 
+// Entity types excluded from search results.
+var searchExcludedTypes = []string{
+	"CensusTract", "PowerPlant", "PowerPlantUnit", "BiologicalSpecimen",
+}
+
+// getSearchSQL gets the SQL to search entities whose names contain all the
+// tokens.
+func getSearchSQL(d base.Dialect, db string, tokens []string, maxResults int32) string {
+	sql := fmt.Sprintf("SELECT id, type, extended_name FROM %s", d.Table(db, "Instance"))
+	for i, t := range searchExcludedTypes {
+		if i == 0 {
+			sql += " WHERE "
+		} else {
+			sql += " AND "
+		}
+		sql += "type != " + d.String(t)
+	}
+	for _, token := range tokens {
+		if token == "" {
+			continue
+		}
+		sql += " AND " + d.ContainsWord("LOWER(extended_name)", token)
+	}
+	if maxResults > 0 {
+		sql += fmt.Sprintf(" LIMIT %d", maxResults)
+	}
+	return sql
+}
+
 // Search implements API for Mixer.Search.
 func (s *Server) Search(
 	ctx context.Context, in *pb.SearchRequest) (*pb.SearchResponse, error) {
 	result := map[string]*pb.SearchResultSection{}
 	tokens := strings.Split(strings.ToLower(in.GetQuery()), " ")
-	qStr := fmt.Sprintf(
-		"SELECT id, type, extended_name FROM `%s`.Instance "+
-			"WHERE type != \"CensusTract\" and type != \"PowerPlant\""+
-			" and type != \"PowerPlantUnit\""+
-			" and type != \"BiologicalSpecimen\"", s.metadata.Bq)
-	for _, token := range tokens {
-		qStr += fmt.Sprintf(
-			` AND REGEXP_CONTAINS(LOWER(extended_name), r"\b%s\b")`, token)
-	}
-	if in.GetMaxResults() > 0 {
-		qStr += fmt.Sprintf(" LIMIT %d", in.GetMaxResults())
-	}
-	q := s.store.BqClient.Query(qStr)
-	it, err := q.Read(ctx)
+	qStr := getSearchSQL(s.store.Executor.Dialect(), s.metadata.Bq, tokens, in.GetMaxResults())
+	it, err := s.store.Executor.Query(ctx, qStr)
 	if err != nil {
 		return nil, err
 	}
+	defer it.Close()
 	for {
 		var row []bigquery.Value
 		err := it.Next(&row)
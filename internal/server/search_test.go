@@ -0,0 +1,70 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"testing"
+
+	"github.com/datacommonsorg/mixer/internal/base"
+)
+
+func TestGetSearchSQL(t *testing.T) {
+	excluded := `type != "CensusTract" AND type != "PowerPlant" AND ` +
+		`type != "PowerPlantUnit" AND type != "BiologicalSpecimen"`
+	for _, c := range []struct {
+		dialect    base.Dialect
+		db         string
+		tokens     []string
+		maxResults int32
+		want       string
+	}{
+		{
+			base.BigQuery,
+			"dc",
+			[]string{"santa", "", "clara"},
+			10,
+			"SELECT id, type, extended_name FROM `dc.Instance` WHERE " + excluded +
+				` AND REGEXP_CONTAINS(LOWER(extended_name), "\\bsanta\\b")` +
+				` AND REGEXP_CONTAINS(LOWER(extended_name), "\\bclara\\b") LIMIT 10`,
+		},
+		{
+			base.PostgreSQL,
+			"",
+			[]string{"st."},
+			0,
+			`SELECT id, type, extended_name FROM "Instance" WHERE ` +
+				`type != 'CensusTract' AND type != 'PowerPlant' AND ` +
+				`type != 'PowerPlantUnit' AND type != 'BiologicalSpecimen'` +
+				` AND LOWER(extended_name) ~ '\yst\.\y'`,
+		},
+		{
+			base.SQLite,
+			"",
+			[]string{"o'hare"},
+			5,
+			`SELECT id, type, extended_name FROM "Instance" WHERE ` +
+				`type != 'CensusTract' AND type != 'PowerPlant' AND ` +
+				`type != 'PowerPlantUnit' AND type != 'BiologicalSpecimen'` +
+				` AND (' ' || LOWER(extended_name) || ' ') LIKE '% o''hare %' ESCAPE '\'` +
+				` LIMIT 5`,
+		},
+	} {
+		got := getSearchSQL(c.dialect, c.db, c.tokens, c.maxResults)
+		if got != c.want {
+			t.Errorf("getSearchSQL(%s, %v) =\n%s\nwant\n%s",
+				c.dialect.Name(), c.tokens, got, c.want)
+		}
+	}
+}
@@ -19,10 +19,7 @@ import (
 	"fmt"
 	"io/ioutil"
 	"log"
-	"path"
-	"path/filepath"
-	"runtime"
-	"strings"
+	"sync"
 	"time"
 
 	"cloud.google.com/go/bigquery"
@@ -54,6 +51,20 @@ type Metadata struct {
 	Bq               string
 	BtProject        string
 	BranchBtInstance string
+	// Maximum bytes a sparql query may process in BigQuery, 0 for no limit.
+	MaxQueryBytes int64
+	// Directory of the schema mapping files.
+	SchemaPath string
+	// SQL dialect of the schema mappings and the translated queries, BigQuery
+	// if not set.
+	Dialect     base.Dialect
+	mappingLock sync.RWMutex
+	// BranchSentinelKeys are the row keys that a new branch cache table must
+	// have before it is used.
+	BranchSentinelKeys []string
+	// ContainedIn has the containedInPlace depths between place types, to
+	// translate containedInPlace+ in sparql queries.
+	ContainedIn map[util.TypePair][]int
 }
 
 // Server holds resources for a mixer server
@@ -63,16 +74,6 @@ type Server struct {
 	cache    *Cache
 }
 
-func (s *Server) updateBranchTable(ctx context.Context, branchTableName string) {
-	branchTable, err := NewBtTable(
-		ctx, s.metadata.BtProject, s.metadata.BranchBtInstance, branchTableName)
-	if err != nil {
-		log.Printf("Failed to udpate branch cache Bigtable client: %v", err)
-		return
-	}
-	s.store.UpdateBranchBt(branchTable)
-}
-
 // ReadBranchTableName reads branch cache folder from GCS.
 func ReadBranchTableName(
 	ctx context.Context, bucket, versionFile string) (string, error) {
@@ -95,40 +96,34 @@ func ReadBranchTableName(
 // NewMetadata initialize the metadata for translator.
 func NewMetadata(
 	bqDataset, storeProject, branchInstance, schemaPath string) (*Metadata, error) {
-	_, filename, _, _ := runtime.Caller(0)
-	subTypeMap, err := translator.GetSubTypeMap(
-		path.Join(path.Dir(filename), "../translator/table_types.json"))
+	subTypeMap, err := translator.GetSubTypeMap("")
 	if err != nil {
 		return nil, err
 	}
-	files, err := ioutil.ReadDir(schemaPath)
+	containedIn, err := util.GetContainedInDepths("")
 	if err != nil {
 		return nil, err
 	}
-	mappings := []*base.Mapping{}
-	for _, f := range files {
-		if strings.HasSuffix(f.Name(), ".mcf") {
-			mappingStr, err := ioutil.ReadFile(filepath.Join(schemaPath, f.Name()))
-			if err != nil {
-				return nil, err
-			}
-			mapping, err := translator.ParseMapping(string(mappingStr), bqDataset)
-			if err != nil {
-				return nil, err
-			}
-			mappings = append(mappings, mapping...)
-		}
+	files, err := readMappingFiles(schemaPath)
+	if err != nil {
+		return nil, err
+	}
+	mappings, err := parseMappingFiles(files, bqDataset, base.BigQuery, false)
+	if err != nil {
+		return nil, err
 	}
 	outArcInfo := map[string]map[string][]translator.OutArcInfo{}
 	inArcInfo := map[string][]translator.InArcInfo{}
 	return &Metadata{
-			mappings,
-			outArcInfo,
-			inArcInfo,
-			subTypeMap,
-			bqDataset,
-			storeProject,
-			branchInstance,
+			Mappings:         mappings,
+			OutArcInfo:       outArcInfo,
+			InArcInfo:        inArcInfo,
+			SubTypeMap:       subTypeMap,
+			ContainedIn:      containedIn,
+			Bq:               bqDataset,
+			BtProject:        storeProject,
+			BranchBtInstance: branchInstance,
+			SchemaPath:       schemaPath,
 		},
 		nil
 }
@@ -173,7 +168,9 @@ func (s *Server) SubscribeBranchCacheUpdate(
 			branchTableName := string(msg.Data)
 			msg.Ack()
 			fmt.Printf("Subscriber action: use branch cache %s\n", branchTableName)
-			s.updateBranchTable(ctx, branchTableName)
+			if err := s.UpdateBranchTable(ctx, branchTableName); err != nil {
+				log.Printf("Failed to udpate branch cache Bigtable client: %v", err)
+			}
 		})
 		if err != nil {
 			log.Printf("Cloud pubsub receive: %v", err)
@@ -183,7 +180,7 @@ func (s *Server) SubscribeBranchCacheUpdate(
 }
 
 // NewCache initializes the cache for stat var hierarchy.
-func NewCache(ctx context.Context, baseTable *bigtable.Table) (*Cache, error) {
+func NewCache(ctx context.Context, baseTable store.KVReader) (*Cache, error) {
 	rawSvg, err := GetRawSvg(ctx, baseTable)
 	if err != nil {
 		return nil, err
@@ -197,6 +194,32 @@ func NewCache(ctx context.Context, baseTable *bigtable.Table) (*Cache, error) {
 	}, nil
 }
 
+// SetQueryExecutor sets the executor of SQL queries, which is BigQuery by
+// default. The schema mappings are reloaded in the SQL dialect of the executor,
+// with validation problems logged as at startup.
+func (s *Server) SetQueryExecutor(e store.QueryExecutor) error {
+	s.store.Executor = e
+	s.metadata.Dialect = e.Dialect()
+	files, err := readMappingFiles(s.metadata.SchemaPath)
+	if err != nil {
+		return err
+	}
+	return s.reloadMappingFiles(files, false)
+}
+
+// SetCacheReaders sets the readers of the base and branch caches, which are
+// Bigtable tables by default. It is not safe to call while serving.
+func (s *Server) SetCacheReaders(base, branch store.KVReader) {
+	s.store.SetBaseBt(base)
+	s.store.UpdateBranchBt(branch)
+}
+
+// SetRowCache sets the in-memory cache of decoded cache table rows. It is not
+// safe to call while serving.
+func (s *Server) SetRowCache(rowCache *store.RowCache) {
+	s.store.SetRowCache(rowCache)
+}
+
 // NewServer creates a new server instance.
 func NewServer(
 	bqClient *bigquery.Client,
@@ -204,8 +227,10 @@ func NewServer(
 	branchTable *bigtable.Table,
 	metadata *Metadata,
 	cache *Cache) *Server {
+	st := store.NewStore(bqClient, baseTable, branchTable)
+	st.SetMergePolicy(newMergePolicy())
 	return &Server{
-		store:    store.NewStore(bqClient, baseTable, branchTable),
+		store:    st,
 		metadata: metadata,
 		cache:    cache,
 	}
@@ -23,7 +23,6 @@ import (
 )
 
 // readStats reads and process BigTable rows in parallel.
-// Consider consolidate this function and bigTableReadRowsParallel.
 func readStats(
 	ctx context.Context,
 	store *store.Store,
@@ -32,8 +31,8 @@ func readStats(
 	map[string]map[string]*ObsTimeSeries, error) {
 
 	keyToTokenFn := tokenFn(keyTokens)
-	baseDataMap, branchDataMap, err := bigTableReadRowsParallel(
-		ctx, store, rowList, convertToObsSeries, tokenFn(keyTokens), true, /* readBranch */
+	dataMap, err := bigTableReadRowsMerged(
+		ctx, store, rowList, convertToObsSeries, keyToTokenFn,
 	)
 	if err != nil {
 		return nil, err
@@ -50,17 +49,14 @@ func readStats(
 	for _, rowKey := range rowList {
 		token, _ := keyToTokenFn(rowKey)
 		psv := keyTokens[rowKey]
-		if data, ok := branchDataMap[token]; ok {
-			result[psv.place][psv.statVar] = data.(*ObsTimeSeries)
-		} else if data, ok := baseDataMap[token]; ok {
+		if data, ok := dataMap[token]; ok && data != nil {
 			result[psv.place][psv.statVar] = data.(*ObsTimeSeries)
 		}
 	}
 	return result, nil
 }
 
-// readStats reads and process BigTable rows in parallel.
-// Consider consolidate this function and bigTableReadRowsParallel.
+// readStatsPb reads and process BigTable rows in parallel.
 func readStatsPb(
 	ctx context.Context,
 	store *store.Store,
@@ -69,8 +65,8 @@ func readStatsPb(
 	map[string]map[string]*pb.ObsTimeSeries, error) {
 
 	keyToTokenFn := tokenFn(keyTokens)
-	baseDataMap, branchDataMap, err := bigTableReadRowsParallel(
-		ctx, store, rowList, convertToObsSeriesPb, keyToTokenFn, true, /* readBranch */
+	dataMap, err := bigTableReadRowsMerged(
+		ctx, store, rowList, convertToObsSeriesPb, keyToTokenFn,
 	)
 	if err != nil {
 		return nil, err
@@ -88,9 +84,7 @@ func readStatsPb(
 	for _, rowKey := range rowList {
 		token, _ := keyToTokenFn(rowKey)
 		psv := keyTokens[rowKey]
-		if data, ok := branchDataMap[token]; ok && data != nil {
-			result[psv.place][psv.statVar] = data.(*pb.ObsTimeSeries)
-		} else if data, ok := baseDataMap[token]; ok && data != nil {
+		if data, ok := dataMap[token]; ok && data != nil {
 			result[psv.place][psv.statVar] = data.(*pb.ObsTimeSeries)
 		}
 	}
@@ -106,7 +100,7 @@ func readStatCollection(
 	keyTokens map[string]string) (
 	map[string]*pb.ObsCollection, error) {
 
-	baseDataMap, branchDataMap, err := bigTableReadRowsParallel(
+	dataMap, err := bigTableReadRowsMerged(
 		ctx,
 		store,
 		rowList,
@@ -114,7 +108,6 @@ func readStatCollection(
 		func(rowKey string) (string, error) {
 			return keyTokens[rowKey], nil
 		},
-		true, /* readBranch */
 	)
 	if err != nil {
 		return nil, err
@@ -122,9 +115,7 @@ func readStatCollection(
 	result := map[string]*pb.ObsCollection{}
 	for _, rowKey := range rowList {
 		token := keyTokens[rowKey]
-		if data, ok := branchDataMap[token]; ok && data != nil {
-			result[token] = data.(*pb.ObsCollection)
-		} else if data, ok := baseDataMap[token]; ok && data != nil {
+		if data, ok := dataMap[token]; ok && data != nil {
 			result[token] = data.(*pb.ObsCollection)
 		} else {
 			result[token] = nil
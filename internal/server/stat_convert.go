@@ -64,6 +64,7 @@ func convertToObsSeries(token string, jsonRaw []byte) (
 				Unit:              source.GetUnit(),
 				ProvenanceURL:     source.GetProvenanceUrl(),
 				Val:               source.GetVal(),
+				IsDcAggregate:     source.GetIsDcAggregate(),
 			}
 		}
 		ret.ProvenanceURL = x.ObsTimeSeries.GetProvenanceUrl()
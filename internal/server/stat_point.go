@@ -157,7 +157,8 @@ func (s *Server) GetStatSetWithinPlace(
 
 	// Get all the child places
 	rowList := buildPlaceInKey([]string{parentPlace}, childType)
-	// Place relations are from base geo imports. Only trust the base cache.
+	// Place relations are from base geo imports. Only trust the base cache, as
+	// noted in newMergePolicy.
 	baseDataMap, _, err := bigTableReadRowsParallel(
 		ctx,
 		s.store,
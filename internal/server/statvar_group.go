@@ -125,14 +125,13 @@ func (s *Server) GetStatVarGroup(
 	}
 
 	// Read stat var group cache data
-	row, err := s.store.BaseBt().ReadRow(ctx, util.BtStatVarGroup)
+	raw, err := s.store.BaseBt().Get(ctx, util.BtStatVarGroup)
 	if err != nil {
 		return nil, err
 	}
-	if len(row[util.BtFamily]) == 0 {
+	if raw == nil {
 		return nil, status.Errorf(codes.NotFound, "Stat Var Group not found in cache")
 	}
-	raw := row[util.BtFamily][0].Value
 	jsonRaw, err := util.UnzipAndDecode(string(raw))
 	if err != nil {
 		return nil, err
@@ -335,7 +334,7 @@ func checkStatExistence(
 	places []string) (map[string]int, error) {
 	rowList, keyTokens := buildStatExistenceKey(places, svOrSvgs)
 	keyToTokenFn := tokenFn(keyTokens)
-	baseDataMap, _, err := bigTableReadRowsParallel(
+	dataMap, err := bigTableReadRowsMerged(
 		ctx,
 		store,
 		rowList,
@@ -345,7 +344,6 @@ func checkStatExistence(
 			return nil, nil
 		},
 		keyToTokenFn,
-		false, /* readBranch */
 	)
 	if err != nil {
 		return nil, err
@@ -354,7 +352,7 @@ func checkStatExistence(
 	for _, rowKey := range rowList {
 		placeSv := keyTokens[rowKey]
 		token, _ := keyToTokenFn(rowKey)
-		if _, ok := baseDataMap[token]; ok {
+		if _, ok := dataMap[token]; ok {
 			result[placeSv.statVar]++
 		}
 	}
@@ -19,8 +19,8 @@ import (
 	"sort"
 	"strings"
 
-	"cloud.google.com/go/bigtable"
 	pb "github.com/datacommonsorg/mixer/internal/proto"
+	"github.com/datacommonsorg/mixer/internal/store"
 	"github.com/datacommonsorg/mixer/internal/util"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -49,17 +49,16 @@ type SearchIndex struct {
 const nonHumanCuratedNumPv = 30
 
 // GetRawSvg gets the raw svg mapping.
-func GetRawSvg(ctx context.Context, baseTable *bigtable.Table) (
+func GetRawSvg(ctx context.Context, baseTable store.KVReader) (
 	map[string]*pb.StatVarGroupNode, error) {
 	svgResp := &pb.StatVarGroups{}
-	row, err := baseTable.ReadRow(ctx, util.BtStatVarGroup)
+	raw, err := baseTable.Get(ctx, util.BtStatVarGroup)
 	if err != nil {
 		return nil, err
 	}
-	if len(row[util.BtFamily]) == 0 {
+	if raw == nil {
 		return nil, status.Errorf(codes.NotFound, "Stat Var Group not found in cache")
 	}
-	raw := row[util.BtFamily][0].Value
 	jsonRaw, err := util.UnzipAndDecode(string(raw))
 	if err != nil {
 		return nil, err
@@ -67,7 +67,8 @@ func getObsTriples(
 				}
 				`, selectStatment, tripleStatment,
 	)
-	resp, err := s.Query(ctx, &pb.QueryRequest{Sparql: sparql})
+	// The byte limit of user queries does not apply to this internal query.
+	resp, err := s.query(ctx, &pb.QueryRequest{Sparql: sparql}, false)
 	if err != nil {
 		return nil, err
 	}
@@ -135,6 +136,22 @@ func (s *Server) GetTriples(ctx context.Context, in *pb.GetTriplesRequest) (
 		return nil, status.Errorf(codes.InvalidArgument, "Invalid DCIDs")
 	}
 
+	resultsMap, err := s.getTriples(ctx, dcids, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	// Format the json response and encode it in base64 as necessary.
+	jsonRaw, err := json.Marshal(resultsMap)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.GetTriplesResponse{Payload: string(jsonRaw)}, nil
+}
+
+// getTriples gets the triples of nodes keyed by dcid.
+func (s *Server) getTriples(ctx context.Context, dcids []string, limit int32) (
+	map[string][]*Triple, error) {
 	// Need to fetch additional information for observation node.
 	var regDcids, obsDcids []string
 	for _, dcid := range dcids {
@@ -168,13 +185,7 @@ func (s *Server) GetTriples(ctx context.Context, in *pb.GetTriplesRequest) (
 			resultsMap[k] = append(resultsMap[k], v...)
 		}
 	}
-
-	// Format the json response and encode it in base64 as necessary.
-	jsonRaw, err := json.Marshal(resultsMap)
-	if err != nil {
-		return nil, err
-	}
-	return &pb.GetTriplesResponse{Payload: string(jsonRaw)}, nil
+	return resultsMap, nil
 }
 
 func convertTriplesCache(dcid string, jsonRaw []byte) (interface{}, error) {
@@ -228,21 +239,16 @@ func applyLimit(
 	return result
 }
 
-// ReadTriples read triples from base cache for multiple dcids.
+// ReadTriples read triples from base and branch cache for multiple dcids.
 func readTriples(
 	ctx context.Context, store *store.Store, rowList bigtable.RowList) (
 	map[string]*TriplesCache, error) {
-	// Only use base cache for triples, as branch cache only consists increment
-	// stats. This saves time as the triples list size can get big.
-	// Re-evaluate this if branch cache involves other triples.
-	baseDataMap, _, err := bigTableReadRowsParallel(
-		ctx, store, rowList, convertTriplesCache, nil, false, /* readBranch */
-	)
+	dataMap, err := bigTableReadRowsMerged(ctx, store, rowList, convertTriplesCache, nil)
 	if err != nil {
 		return nil, err
 	}
 	result := make(map[string]*TriplesCache)
-	for dcid, data := range baseDataMap {
+	for dcid, data := range dataMap {
 		if data == nil {
 			result[dcid] = nil
 		} else {
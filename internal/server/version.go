@@ -19,16 +19,27 @@ import (
 	"os"
 
 	pb "github.com/datacommonsorg/mixer/internal/proto"
+	"github.com/datacommonsorg/mixer/internal/store"
 )
 
 // GetVersion implements API for Mixer.GetVersion.
 func (s *Server) GetVersion(ctx context.Context,
 	in *pb.GetVersionRequest) (*pb.GetVersionResponse, error) {
 
-	return &pb.GetVersionResponse{
+	resp := &pb.GetVersionResponse{
 		Store:    os.Getenv("STORE_PROJECT"),
 		BigQuery: os.Getenv("BIG_QUERY"),
 		BigTable: os.Getenv("BIG_TABLE"),
 		GitHash:  os.Getenv("MIXER_HASH"),
-	}, nil
+	}
+	for _, l := range s.store.BranchLayers() {
+		if l.Name != store.DefaultBranchLayer {
+			continue
+		}
+		resp.BranchTable = l.Table
+		if l.Previous != nil {
+			resp.PreviousBranchTable = l.Previous.Table
+		}
+	}
+	return resp, nil
 }
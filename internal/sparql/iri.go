@@ -0,0 +1,188 @@
+// Copyright 2019 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sparql
+
+import (
+	"net/url"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// defaultPrefixes are the prefixes that can be used without a PREFIX
+// declaration.
+var defaultPrefixes = map[string]string{
+	"dcid:":   "https://datacommons.org/browser/",
+	"dcs:":    "https://datacommons.org/browser/",
+	"schema:": "https://schema.org/",
+}
+
+// localNamespaces are the namespaces whose IRIs are referred by local names in
+// the schema mappings.
+var localNamespaces = []string{
+	"https://datacommons.org/browser/",
+	"http://datacommons.org/browser/",
+	"https://schema.org/",
+	"http://schema.org/",
+}
+
+// iriResolver resolves IRIs and prefixed names in a query to the names used in
+// the schema mappings.
+type iriResolver struct {
+	base   *url.URL
+	prefix map[string]string
+}
+
+// newIRIResolver creates an iriResolver from the query prologue.
+func newIRIResolver(prologue *Prologue) (*iriResolver, error) {
+	r := &iriResolver{prefix: map[string]string{}}
+	for k, v := range defaultPrefixes {
+		r.prefix[k] = v
+	}
+	if prologue == nil {
+		return r, nil
+	}
+	if prologue.Base != "" {
+		u, err := url.Parse(trimIRI(prologue.Base))
+		if err != nil || !u.IsAbs() {
+			return nil, status.Errorf(
+				codes.InvalidArgument, "Invalid BASE IRI %s", prologue.Base)
+		}
+		r.base = u
+	}
+	for k, v := range prologue.Prefix {
+		r.prefix[k] = trimIRI(v)
+	}
+	return r, nil
+}
+
+// trimIRI removes the angle brackets around an IRI.
+func trimIRI(iri string) string {
+	return strings.TrimSuffix(strings.TrimPrefix(iri, "<"), ">")
+}
+
+// resolve converts an IRI or a prefixed name to a local name when it is in one
+// of the local namespaces. Other terms are returned unchanged, except that
+// IRIs are resolved against the BASE IRI.
+func (r *iriResolver) resolve(term string) (string, error) {
+	var iri string
+	if strings.HasPrefix(term, "<") && strings.HasSuffix(term, ">") {
+		iri = trimIRI(term)
+	} else if i := strings.Index(term, ":"); i > 0 && !strings.HasPrefix(term, "\"") {
+		ns, ok := r.prefix[term[:i+1]]
+		if !ok {
+			return term, nil
+		}
+		iri = ns + term[i+1:]
+	} else {
+		return term, nil
+	}
+	if r.base != nil {
+		u, err := url.Parse(iri)
+		if err != nil {
+			return "", status.Errorf(codes.InvalidArgument, "Invalid IRI %s", term)
+		}
+		iri = r.base.ResolveReference(u).String()
+	}
+	for _, ns := range localNamespaces {
+		if strings.HasPrefix(iri, ns) {
+			return strings.TrimPrefix(iri, ns), nil
+		}
+	}
+	return iri, nil
+}
+
+// resolvePred resolves the predicate of a triple. Each step of a property path
+// is resolved on its own, so IRIs with "/" are not taken as paths.
+func (r *iriResolver) resolvePred(t *Triple) error {
+	if len(t.Path) == 0 {
+		pred, err := r.resolve(t.Pred)
+		if err != nil {
+			return err
+		}
+		t.Pred = pred
+		return nil
+	}
+	steps := []string{}
+	for i := range t.Path {
+		pred, err := r.resolve(t.Path[i].Pred)
+		if err != nil {
+			return err
+		}
+		t.Path[i].Pred = pred
+		steps = append(steps, pred+t.Path[i].Modifier)
+	}
+	t.Pred = strings.Join(steps, "/")
+	return nil
+}
+
+// resolveExpr resolves the IRIs and prefixed names in a filter expression.
+func (r *iriResolver) resolveExpr(e Expr) error {
+	switch v := e.(type) {
+	case *BinaryExpr:
+		if err := r.resolveExpr(v.LHS); err != nil {
+			return err
+		}
+		return r.resolveExpr(v.RHS)
+	case *UnaryExpr:
+		return r.resolveExpr(v.Expr)
+	case *IRIRef:
+		val, err := r.resolve(v.Val)
+		if err != nil {
+			return err
+		}
+		v.Val = val
+	}
+	return nil
+}
+
+// resolveWhere resolves the terms of all the triples and filters in a graph
+// pattern.
+func (r *iriResolver) resolveWhere(w *Where) error {
+	var err error
+	for i := range w.Triples {
+		t := &w.Triples[i]
+		if t.Sub, err = r.resolve(t.Sub); err != nil {
+			return err
+		}
+		if err = r.resolvePred(t); err != nil {
+			return err
+		}
+		for j := range t.Objs {
+			if t.Objs[j], err = r.resolve(t.Objs[j]); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range w.Filters {
+		if err := r.resolveExpr(f.Expr); err != nil {
+			return err
+		}
+	}
+	for i := range w.Optionals {
+		if err := r.resolveWhere(&w.Optionals[i]); err != nil {
+			return err
+		}
+	}
+	for i := range w.Unions {
+		for j := range w.Unions[i].Branches {
+			if err := r.resolveWhere(&w.Unions[i].Branches[j]); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,167 @@
+// Copyright 2019 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sparql
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/go-test/deep"
+)
+
+func TestResolve(t *testing.T) {
+	for _, c := range []struct {
+		prologue *Prologue
+		term     string
+		want     string
+	}{
+		{
+			nil,
+			"dcs:Count_Person",
+			"Count_Person",
+		},
+		{
+			nil,
+			"dcid:geoId/06",
+			"geoId/06",
+		},
+		{
+			nil,
+			"schema:name",
+			"name",
+		},
+		{
+			nil,
+			"<http://schema.org/name>",
+			"name",
+		},
+		{
+			nil,
+			"<https://datacommons.org/browser/geoId/06>",
+			"geoId/06",
+		},
+		{
+			nil,
+			"<https://www.census.gov/>",
+			"https://www.census.gov/",
+		},
+		{
+			nil,
+			"?place",
+			"?place",
+		},
+		{
+			nil,
+			"\"dcs:Count_Person\"",
+			"\"dcs:Count_Person\"",
+		},
+		{
+			nil,
+			"foo:bar",
+			"foo:bar",
+		},
+		{
+			&Prologue{Base: "<https://datacommons.org/browser/>"},
+			"<geoId/06>",
+			"geoId/06",
+		},
+		{
+			&Prologue{
+				Base:   "<https://datacommons.org/>",
+				Prefix: map[string]string{"ex:": "<browser/>"},
+			},
+			"ex:Count_Person",
+			"Count_Person",
+		},
+		{
+			&Prologue{Prefix: map[string]string{"dcs:": "<https://example.org/>"}},
+			"dcs:Count_Person",
+			"https://example.org/Count_Person",
+		},
+	} {
+		r, err := newIRIResolver(c.prologue)
+		if err != nil {
+			t.Errorf("newIRIResolver(%v) = %s", c.prologue, err)
+			continue
+		}
+		got, err := r.resolve(c.term)
+		if err != nil {
+			t.Errorf("resolve(%s) = %s", c.term, err)
+			continue
+		}
+		if diff := deep.Equal(c.want, got); diff != nil {
+			t.Errorf("resolve(%s) unexpected diff %v", c.term, diff)
+		}
+	}
+}
+
+func TestNewIRIResolverError(t *testing.T) {
+	if _, err := newIRIResolver(&Prologue{Base: "<relative/path>"}); err == nil {
+		t.Errorf("newIRIResolver() = nil, want error for relative BASE")
+	}
+}
+
+func TestResolveWhereFilter(t *testing.T) {
+	for _, c := range []struct {
+		query string
+		want  []Filter
+	}{
+		{
+			`SELECT ?p WHERE {?p typeOf ?t . FILTER(?t = dcs:County)}`,
+			[]Filter{{&BinaryExpr{Op: EQ, LHS: &VarRef{"?t"}, RHS: &IRIRef{"County"}}}},
+		},
+		{
+			`SELECT ?p WHERE {
+				?p containedInPlace ?x .
+				FILTER(?x = <http://datacommons.org/browser/geoId/06> || ?x = dcid:geoId/07)
+			}`,
+			[]Filter{{&BinaryExpr{
+				Op:  OR,
+				LHS: &BinaryExpr{Op: EQ, LHS: &VarRef{"?x"}, RHS: &IRIRef{"geoId/06"}},
+				RHS: &BinaryExpr{Op: EQ, LHS: &VarRef{"?x"}, RHS: &IRIRef{"geoId/07"}},
+			}}},
+		},
+		{
+			`PREFIX ex: <https://schema.org/>
+			SELECT ?p WHERE {?p typeOf ?t . FILTER(!(?t = ex:Place) && ?t != "dcs:County")}`,
+			[]Filter{{&BinaryExpr{
+				Op: AND,
+				LHS: &UnaryExpr{
+					Op:   NOT,
+					Expr: &BinaryExpr{Op: EQ, LHS: &VarRef{"?t"}, RHS: &IRIRef{"Place"}},
+				},
+				RHS: &BinaryExpr{Op: NEQ, LHS: &VarRef{"?t"}, RHS: &StringLiteral{"dcs:County"}},
+			}}},
+		},
+	} {
+		tree, err := NewParser(strings.NewReader(c.query)).Parse()
+		if err != nil {
+			t.Errorf("Parse(%s) = %s", c.query, err)
+			continue
+		}
+		r, qErr := newIRIResolver(tree.P)
+		if qErr != nil {
+			t.Errorf("newIRIResolver(%v) = %s", tree.P, qErr)
+			continue
+		}
+		if qErr := r.resolveWhere(tree.W); qErr != nil {
+			t.Errorf("resolveWhere(%s) = %s", c.query, qErr)
+			continue
+		}
+		if diff := deep.Equal(c.want, tree.W.Filters); diff != nil {
+			t.Errorf("resolveWhere(%s) unexpected diff %v", c.query, diff)
+		}
+	}
+}
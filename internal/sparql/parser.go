@@ -18,6 +18,7 @@ import (
 	"fmt"
 	"io"
 	"strconv"
+	"strings"
 )
 
 // ParseError represents an error that occurred during parsing.
@@ -33,13 +34,27 @@ func newParseError(found string, expected []string, pos Pos) *ParseError {
 	return &ParseError{Found: found, Expected: expected, Pos: pos}
 }
 
+// Error returns the string representation of the error.
+func (e *ParseError) Error() string {
+	if e.Message != "" {
+		return fmt.Sprintf("%s at line %d, char %d", e.Message, e.Pos.Line+1, e.Pos.Char+1)
+	}
+	return fmt.Sprintf("found %s, expected %s at line %d, char %d",
+		e.Found, strings.Join(e.Expected, ", "), e.Pos.Line+1, e.Pos.Char+1)
+}
+
 // QueryTree represents a parsed Sparql syntax tree.
 type QueryTree struct {
 	P *Prologue
 	S *Select
+	C *Construct
+	D *Describe
 	W *Where
-	O *Orderby
+	G *GroupBy
+	O []*Orderby
 	L int
+	// Number of result rows to skip, from the OFFSET clause.
+	Offset int
 }
 
 // Prologue represents query prologue information
@@ -50,8 +65,27 @@ type Prologue struct {
 
 // Select contains information in the SELECT statement.
 type Select struct {
-	Variable []string
+	// Selected variables in order, including the aliases of aggregates.
+	Variable   []string
+	Distinct   bool
+	Aggregates []Aggregate
+}
+
+// Aggregate represents an aggregate projection like (COUNT(?a) AS ?count).
+type Aggregate struct {
+	Func     string
+	Variable string
 	Distinct bool
+	Alias    string
+}
+
+// aggregateFuncs contains the supported aggregate functions.
+var aggregateFuncs = map[string]struct{}{
+	"COUNT": {},
+	"SUM":   {},
+	"AVG":   {},
+	"MIN":   {},
+	"MAX":   {},
 }
 
 // Triple reprensts a triple in Sparql query.
@@ -59,14 +93,137 @@ type Triple struct {
 	Sub  string
 	Pred string
 	Objs []string
+	// Steps of the predicate when it is a property path, like
+	// "containedInPlace/name" or "containedInPlace+". Pred has the path text.
+	Path []PathStep
+}
+
+// PathStep represents a predicate in a property path, with the "+" or "*"
+// modifier if any.
+type PathStep struct {
+	Pred     string
+	Modifier string
+}
+
+// pathSteps splits a predicate token to the steps of a property path. IRIs are
+// single steps, while prefixed and local names are separated by "/".
+func pathSteps(tok Token, lit string) []PathStep {
+	if tok != IDENT {
+		return []PathStep{{Pred: lit}}
+	}
+	result := []PathStep{}
+	for _, name := range strings.Split(strings.TrimSuffix(lit, "/"), "/") {
+		result = append(result, PathStep{Pred: name})
+	}
+	return result
 }
 
 // Where represents the where condition in Sparql query.
 type Where struct {
+	Triples   []Triple
+	Filters   []Filter
+	Optionals []Where
+	Unions    []Union
+}
+
+// Union represents alternative graph patterns combined by UNION.
+type Union struct {
+	Branches []Where
+}
+
+// Filter represents a FILTER constraint in the where condition.
+type Filter struct {
+	Expr Expr
+}
+
+// Expr represents an expression in a FILTER constraint.
+type Expr interface {
+	String() string
+}
+
+// BinaryExpr represents a comparison or boolean operation on two expressions.
+type BinaryExpr struct {
+	Op  Token
+	LHS Expr
+	RHS Expr
+}
+
+func (e *BinaryExpr) String() string {
+	return fmt.Sprintf("(%s %s %s)", e.LHS, e.Op, e.RHS)
+}
+
+// UnaryExpr represents a negated expression.
+type UnaryExpr struct {
+	Op   Token
+	Expr Expr
+}
+
+func (e *UnaryExpr) String() string {
+	return fmt.Sprintf("%s%s", e.Op, e.Expr)
+}
+
+// VarRef represents a reference to a query variable.
+type VarRef struct {
+	Val string
+}
+
+func (v *VarRef) String() string {
+	return v.Val
+}
+
+// StringLiteral represents a quoted string literal.
+type StringLiteral struct {
+	Val string
+}
+
+func (l *StringLiteral) String() string {
+	return strconv.Quote(l.Val)
+}
+
+// IRIRef represents an IRI, a prefixed name or a bare identifier like a dcid.
+type IRIRef struct {
+	Val string
+}
+
+func (r *IRIRef) String() string {
+	return r.Val
+}
+
+// NumberLiteral represents a numeric literal.
+type NumberLiteral struct {
+	Val float64
+}
+
+func (l *NumberLiteral) String() string {
+	return strconv.FormatFloat(l.Val, 'f', -1, 64)
+}
+
+// BooleanLiteral represents a boolean literal.
+type BooleanLiteral struct {
+	Val bool
+}
+
+func (l *BooleanLiteral) String() string {
+	return strconv.FormatBool(l.Val)
+}
+
+// GroupBy represents the group by condition.
+type GroupBy struct {
+	Variable []string
+}
+
+// Construct represents the triple template of a CONSTRUCT query.
+type Construct struct {
 	Triples []Triple
 }
 
-// Orderby represents the order by condition.
+// Describe represents the resources of a DESCRIBE query, which are variables
+// or node identifiers.
+type Describe struct {
+	Resources []string
+}
+
+// Orderby represents a sort key of the order by condition.
 type Orderby struct {
 	Variable string
 	ASC      bool
@@ -139,55 +296,230 @@ func (p *Parser) parseSelect() (*Select, *ParseError) {
 			p.Unscan()
 			return &result, nil
 		}
+		if tok == LPAREN {
+			agg, err := p.parseAggregate()
+			if err != nil {
+				return nil, err
+			}
+			result.Aggregates = append(result.Aggregates, *agg)
+			result.Variable = append(result.Variable, agg.Alias)
+			continue
+		}
 		if tok != VARIABLE {
-			return nil, newParseError(tokstr(tok, lit), []string{"?..."}, pos)
+			return nil, newParseError(tokstr(tok, lit), []string{"?...", "("}, pos)
 		}
 		result.Variable = append(result.Variable, lit)
 	}
 }
 
+// parseConstruct parses the triple template of a CONSTRUCT query, with the
+// CONSTRUCT keyword already consumed.
+func (p *Parser) parseConstruct() (*Construct, *ParseError) {
+	tok, pos, lit := p.ScanIgnoreWhitespace()
+	p.Unscan()
+	template, err := p.parseGroup()
+	if err != nil {
+		return nil, err
+	}
+	if len(template.Filters) > 0 || len(template.Optionals) > 0 || len(template.Unions) > 0 {
+		return nil, newParseError(tokstr(tok, lit), []string{"triple template"}, pos)
+	}
+	return &Construct{Triples: template.Triples}, nil
+}
+
+// templateVariables gets the distinct variables of triples in the order of
+// appearance.
+func templateVariables(triples []Triple) []string {
+	result := []string{}
+	seen := map[string]struct{}{}
+	for _, t := range triples {
+		for _, term := range append([]string{t.Sub, t.Pred}, t.Objs...) {
+			if _, ok := seen[term]; ok || !strings.HasPrefix(term, "?") {
+				continue
+			}
+			seen[term] = struct{}{}
+			result = append(result, term)
+		}
+	}
+	return result
+}
+
+// parseDescribe parses the resources of a DESCRIBE query, with the DESCRIBE
+// keyword already consumed.
+func (p *Parser) parseDescribe() (*Describe, *ParseError) {
+	result := Describe{}
+	for {
+		tok, pos, lit := p.ScanIgnoreWhitespace()
+		switch tok {
+		case VARIABLE, IDENT:
+			result.Resources = append(result.Resources, lit)
+		case LT:
+			p.Unscan()
+			result.Resources = append(result.Resources, p.parseURI())
+		default:
+			if len(result.Resources) == 0 {
+				return nil, newParseError(tokstr(tok, lit), []string{"?...", "IDENT"}, pos)
+			}
+			p.Unscan()
+			return &result, nil
+		}
+	}
+}
+
+// parseAggregate parses an aggregate projection like (COUNT(?a) AS ?count),
+// with the opening parenthesis already consumed.
+func (p *Parser) parseAggregate() (*Aggregate, *ParseError) {
+	result := Aggregate{}
+	tok, pos, lit := p.ScanIgnoreWhitespace()
+	if _, ok := aggregateFuncs[strings.ToUpper(lit)]; tok != IDENT || !ok {
+		return nil, newParseError(
+			tokstr(tok, lit), []string{"COUNT", "SUM", "AVG", "MIN", "MAX"}, pos)
+	}
+	result.Func = strings.ToUpper(lit)
+	if tok, pos, lit := p.ScanIgnoreWhitespace(); tok != LPAREN {
+		return nil, newParseError(tokstr(tok, lit), []string{"("}, pos)
+	}
+	tok, pos, lit = p.ScanIgnoreWhitespace()
+	if tok == DISTINCT {
+		result.Distinct = true
+		tok, pos, lit = p.ScanIgnoreWhitespace()
+	}
+	if tok != VARIABLE {
+		return nil, newParseError(tokstr(tok, lit), []string{"?..."}, pos)
+	}
+	result.Variable = lit
+	if tok, pos, lit := p.ScanIgnoreWhitespace(); tok != RPAREN {
+		return nil, newParseError(tokstr(tok, lit), []string{")"}, pos)
+	}
+	if tok, pos, lit := p.ScanIgnoreWhitespace(); tok != AS {
+		return nil, newParseError(tokstr(tok, lit), []string{"AS"}, pos)
+	}
+	tok, pos, lit = p.ScanIgnoreWhitespace()
+	if tok != VARIABLE {
+		return nil, newParseError(tokstr(tok, lit), []string{"?..."}, pos)
+	}
+	result.Alias = lit
+	if tok, pos, lit := p.ScanIgnoreWhitespace(); tok != RPAREN {
+		return nil, newParseError(tokstr(tok, lit), []string{")"}, pos)
+	}
+	return &result, nil
+}
+
 func (p *Parser) parseWhere() (*Where, *ParseError) {
-	result := Where{}
 	tok, pos, lit := p.ScanIgnoreWhitespace()
 	if tok != WHERE {
 		return nil, newParseError(tokstr(tok, lit), []string{"Where"}, pos)
 	}
-	tok, pos, lit = p.ScanIgnoreWhitespace()
+	return p.parseGroup()
+}
+
+// parseGroup parses a group graph pattern enclosed in braces.
+func (p *Parser) parseGroup() (*Where, *ParseError) {
+	result := Where{}
+	tok, pos, lit := p.ScanIgnoreWhitespace()
 	if tok != LBRAC {
 		return nil, newParseError(tokstr(tok, lit), []string{"{"}, pos)
 	}
 	var sub string
 	var pred string
 	var objs []string
+	var path []PathStep
+	// Whether the predicate is followed by "/", so the next term is a path step.
+	pathCont := false
 	idx := 0
+	// addTriple adds the pending triple to the result and resets the state.
+	addTriple := func() {
+		if sub != "" && pred != "" {
+			t := Triple{Sub: sub, Pred: pred, Objs: objs}
+			if len(path) > 1 || path[0].Modifier != "" {
+				t.Path = path
+			}
+			result.Triples = append(result.Triples, t)
+		}
+		idx = 0
+		sub = ""
+		pred = ""
+		objs = []string{}
+		path = nil
+		pathCont = false
+	}
 	for {
 		tok, _, lit := p.ScanIgnoreWhitespace()
 		if tok == EOF {
 			return nil, newParseError(tokstr(tok, lit), []string{"}"}, pos)
 		}
 		if tok == RBRAC {
-			if sub != "" && pred != "" {
-				result.Triples = append(result.Triples, Triple{sub, pred, objs})
-			}
+			addTriple()
 			return &result, nil
 		}
 		if tok == DOT {
-			result.Triples = append(result.Triples, Triple{sub, pred, objs})
-			idx = 0
-			sub = ""
-			pred = ""
-			objs = []string{}
+			addTriple()
+			continue
+		}
+		// A filter or an optional pattern can directly follow a triple without
+		// the ending dot.
+		if tok == FILTER {
+			addTriple()
+			filter, err := p.parseFilter()
+			if err != nil {
+				return nil, err
+			}
+			result.Filters = append(result.Filters, *filter)
+			continue
+		}
+		if tok == OPTIONAL {
+			addTriple()
+			optional, err := p.parseGroup()
+			if err != nil {
+				return nil, err
+			}
+			result.Optionals = append(result.Optionals, *optional)
+			continue
+		}
+		if tok == LBRAC {
+			addTriple()
+			p.Unscan()
+			union, err := p.parseUnion()
+			if err != nil {
+				return nil, err
+			}
+			result.Unions = append(result.Unions, *union)
 			continue
 		}
 		if tok == LPAREN || tok == RPAREN {
 			continue
 		}
+		if tok == LT {
+			p.Unscan()
+			lit = p.parseURI()
+		}
+		// Property path operators and steps following the first predicate.
+		if idx == 2 && len(objs) == 0 {
+			if tok == ILLEGAL && (lit == "+" || lit == "*") && !pathCont {
+				pred += lit
+				path[len(path)-1].Modifier = lit
+				continue
+			}
+			if tok == ILLEGAL && lit == "/" && !pathCont {
+				pred += lit
+				pathCont = true
+				continue
+			}
+			if pathCont {
+				pred += lit
+				path = append(path, pathSteps(tok, lit)...)
+				pathCont = tok == IDENT && strings.HasSuffix(lit, "/")
+				continue
+			}
+		}
 		switch idx {
 		case 0:
 			sub = lit
 			idx++
 		case 1:
 			pred = lit
+			path = pathSteps(tok, lit)
+			pathCont = tok == IDENT && strings.HasSuffix(lit, "/")
 			idx++
 		case 2:
 			if tok == STRING {
@@ -198,14 +530,120 @@ func (p *Parser) parseWhere() (*Where, *ParseError) {
 	}
 }
 
-func (p *Parser) parseOrderBy() (*Orderby, *ParseError) {
-	varString := ""
-	asc := true
+// parseUnion parses nested group graph patterns joined by UNION.
+func (p *Parser) parseUnion() (*Union, *ParseError) {
+	result := Union{}
+	for {
+		branch, err := p.parseGroup()
+		if err != nil {
+			return nil, err
+		}
+		result.Branches = append(result.Branches, *branch)
+		if tok, _, _ := p.ScanIgnoreWhitespace(); tok != UNION {
+			p.Unscan()
+			return &result, nil
+		}
+	}
+}
+
+// parseFilter parses the bracketed constraint following a FILTER keyword.
+func (p *Parser) parseFilter() (*Filter, *ParseError) {
+	tok, pos, lit := p.ScanIgnoreWhitespace()
+	if tok != LPAREN {
+		return nil, newParseError(tokstr(tok, lit), []string{"("}, pos)
+	}
+	p.Unscan()
+	expr, err := p.parseExpr()
+	if err != nil {
+		return nil, err
+	}
+	return &Filter{Expr: expr}, nil
+}
+
+// parseExpr parses a filter expression with operator precedence.
+func (p *Parser) parseExpr() (Expr, *ParseError) {
+	return p.parseBinaryExpr(1)
+}
+
+func (p *Parser) parseBinaryExpr(minPrecedence int) (Expr, *ParseError) {
+	lhs, err := p.parseUnaryExpr()
+	if err != nil {
+		return nil, err
+	}
+	for {
+		op, _, _ := p.ScanIgnoreWhitespace()
+		if op.Precedence() < minPrecedence {
+			p.Unscan()
+			return lhs, nil
+		}
+		rhs, err := p.parseBinaryExpr(op.Precedence() + 1)
+		if err != nil {
+			return nil, err
+		}
+		lhs = &BinaryExpr{Op: op, LHS: lhs, RHS: rhs}
+	}
+}
+
+func (p *Parser) parseUnaryExpr() (Expr, *ParseError) {
+	tok, pos, lit := p.ScanIgnoreWhitespace()
+	switch tok {
+	case NOT:
+		expr, err := p.parseUnaryExpr()
+		if err != nil {
+			return nil, err
+		}
+		return &UnaryExpr{Op: NOT, Expr: expr}, nil
+	case LPAREN:
+		expr, err := p.parseExpr()
+		if err != nil {
+			return nil, err
+		}
+		if tok, pos, lit := p.ScanIgnoreWhitespace(); tok != RPAREN {
+			return nil, newParseError(tokstr(tok, lit), []string{")"}, pos)
+		}
+		return expr, nil
+	case VARIABLE:
+		return &VarRef{Val: lit}, nil
+	case STRING:
+		return &StringLiteral{Val: lit}, nil
+	case IDENT:
+		return &IRIRef{Val: lit}, nil
+	case LT:
+		// An operand can not start with the less than operator.
+		p.Unscan()
+		return &IRIRef{Val: p.parseURI()}, nil
+	case TRUE, FALSE:
+		return &BooleanLiteral{Val: tok == TRUE}, nil
+	case NUMBER:
+		v, err := strconv.ParseFloat(lit, 64)
+		if err != nil {
+			return nil, newParseError(tokstr(tok, lit), []string{"NUMBER"}, pos)
+		}
+		return &NumberLiteral{Val: v}, nil
+	case ILLEGAL:
+		// Negative number.
+		if lit == "-" {
+			tok, pos, lit := p.Scan()
+			if tok != NUMBER {
+				return nil, newParseError(tokstr(tok, lit), []string{"NUMBER"}, pos)
+			}
+			v, err := strconv.ParseFloat(lit, 64)
+			if err != nil {
+				return nil, newParseError(tokstr(tok, lit), []string{"NUMBER"}, pos)
+			}
+			return &NumberLiteral{Val: -v}, nil
+		}
+	}
+	return nil, newParseError(
+		tokstr(tok, lit), []string{"(", "!", "?...", "STRING", "IRI", "NUMBER", "BOOLEAN"}, pos)
+}
+
+func (p *Parser) parseGroupBy() (*GroupBy, *ParseError) {
 	tok, _, _ := p.ScanIgnoreWhitespace()
 	if tok == EOF {
 		return nil, nil
 	}
-	if tok != ORDER {
+	if tok != GROUP {
 		p.Unscan()
 		return nil, nil
 	}
@@ -213,49 +651,98 @@ func (p *Parser) parseOrderBy() (*Orderby, *ParseError) {
 	if tok != BY {
 		return nil, newParseError(tokstr(tok, lit), []string{"BY"}, pos)
 	}
-	tok, pos, lit = p.ScanIgnoreWhitespace()
-	if tok == ASC || tok == DESC {
-		asc = tok == ASC
-		tok, pos, lit = p.ScanIgnoreWhitespace()
-		if tok != LPAREN {
-			return nil, newParseError(tokstr(tok, lit), []string{"("}, pos)
-		}
-		tok, pos, lit = p.ScanIgnoreWhitespace()
-		if tok != VARIABLE {
-			return nil, newParseError(tokstr(tok, lit), []string{"?..."}, pos)
-		}
-		varString = lit
-		tok, pos, lit = p.ScanIgnoreWhitespace()
-		if tok != RPAREN {
-			return nil, newParseError(tokstr(tok, lit), []string{")"}, pos)
-		}
-	} else {
+	result := GroupBy{}
+	for {
+		tok, pos, lit := p.ScanIgnoreWhitespace()
 		if tok != VARIABLE {
-			return nil, newParseError(tokstr(tok, lit), []string{"?..."}, pos)
+			if len(result.Variable) == 0 {
+				return nil, newParseError(tokstr(tok, lit), []string{"?..."}, pos)
+			}
+			p.Unscan()
+			return &result, nil
 		}
-		varString = lit
+		result.Variable = append(result.Variable, lit)
 	}
-	return &Orderby{varString, asc}, nil
 }
 
-func (p *Parser) parseLimit() (int, *ParseError) {
-	limit := 0
-	tok, pos, lit := p.ScanIgnoreWhitespace()
+func (p *Parser) parseOrderBy() ([]*Orderby, *ParseError) {
+	tok, _, _ := p.ScanIgnoreWhitespace()
 	if tok == EOF {
-		return 0, nil
+		return nil, nil
 	}
-	if tok != LIMIT {
-		return 0, newParseError(tokstr(tok, lit), []string{"LIMIT"}, pos)
+	if tok != ORDER {
+		p.Unscan()
+		return nil, nil
 	}
-	tok, pos, lit = p.ScanIgnoreWhitespace()
-	if tok != NUMBER {
-		return 0, newParseError(tokstr(tok, lit), []string{"NUMBER"}, pos)
+	tok, pos, lit := p.ScanIgnoreWhitespace()
+	if tok != BY {
+		return nil, newParseError(tokstr(tok, lit), []string{"BY"}, pos)
 	}
-	limit, err := strconv.Atoi(lit)
-	if err != nil {
-		return 0, newParseError(tokstr(tok, lit), []string{"NUMBER"}, pos)
+	result := []*Orderby{}
+	for {
+		tok, pos, lit = p.ScanIgnoreWhitespace()
+		if tok == ASC || tok == DESC {
+			asc := tok == ASC
+			tok, pos, lit = p.ScanIgnoreWhitespace()
+			if tok != LPAREN {
+				return nil, newParseError(tokstr(tok, lit), []string{"("}, pos)
+			}
+			tok, pos, lit = p.ScanIgnoreWhitespace()
+			if tok != VARIABLE {
+				return nil, newParseError(tokstr(tok, lit), []string{"?..."}, pos)
+			}
+			varString := lit
+			tok, pos, lit = p.ScanIgnoreWhitespace()
+			if tok != RPAREN {
+				return nil, newParseError(tokstr(tok, lit), []string{")"}, pos)
+			}
+			result = append(result, &Orderby{varString, asc})
+		} else if tok == VARIABLE {
+			result = append(result, &Orderby{lit, true})
+		} else if len(result) == 0 {
+			return nil, newParseError(tokstr(tok, lit), []string{"?..."}, pos)
+		} else {
+			p.Unscan()
+			return result, nil
+		}
+	}
+}
+
+// parseLimit parses the LIMIT and OFFSET clauses, which can appear in either
+// order.
+func (p *Parser) parseLimit() (int, int, *ParseError) {
+	limit, offset := 0, 0
+	seen := map[Token]bool{}
+	for {
+		tok, pos, lit := p.ScanIgnoreWhitespace()
+		if tok == EOF {
+			return limit, offset, nil
+		}
+		if (tok != LIMIT && tok != OFFSET) || seen[tok] {
+			expected := []string{}
+			for _, t := range []Token{LIMIT, OFFSET} {
+				if !seen[t] {
+					expected = append(expected, t.String())
+				}
+			}
+			return 0, 0, newParseError(tokstr(tok, lit), expected, pos)
+		}
+		seen[tok] = true
+		clause := tok
+		tok, pos, lit = p.ScanIgnoreWhitespace()
+		if tok != NUMBER {
+			return 0, 0, newParseError(tokstr(tok, lit), []string{"NUMBER"}, pos)
+		}
+		n, err := strconv.Atoi(lit)
+		if err != nil {
+			return 0, 0, newParseError(tokstr(tok, lit), []string{"NUMBER"}, pos)
+		}
+		if clause == LIMIT {
+			limit = n
+		} else {
+			offset = n
+		}
 	}
-	return limit, nil
 }
 
 // Parse parses sparql query into syntax tree.
@@ -264,11 +751,49 @@ func (p *Parser) Parse() (*QueryTree, *ParseError) {
 	if err != nil {
 		return nil, err
 	}
-	sel, err := p.parseSelect()
+	var sel *Select
+	var construct *Construct
+	var describe *Describe
+	tok, pos, lit := p.ScanIgnoreWhitespace()
+	switch tok {
+	case SELECT:
+		p.Unscan()
+		sel, err = p.parseSelect()
+	case CONSTRUCT:
+		construct, err = p.parseConstruct()
+		if err == nil {
+			sel = &Select{Variable: templateVariables(construct.Triples)}
+		}
+	case DESCRIBE:
+		describe, err = p.parseDescribe()
+		if err == nil {
+			sel = &Select{}
+			for _, r := range describe.Resources {
+				if strings.HasPrefix(r, "?") {
+					sel.Variable = append(sel.Variable, r)
+				}
+			}
+		}
+	default:
+		err = newParseError(
+			tokstr(tok, lit), []string{"SELECT", "CONSTRUCT", "DESCRIBE"}, pos)
+	}
 	if err != nil {
 		return nil, err
 	}
-	where, err := p.parseWhere()
+	var where *Where
+	if tok, _, _ := p.ScanIgnoreWhitespace(); tok != WHERE && describe != nil {
+		// The WHERE clause is optional for DESCRIBE query.
+		p.Unscan()
+		where = &Where{}
+	} else {
+		p.Unscan()
+		where, err = p.parseWhere()
+		if err != nil {
+			return nil, err
+		}
+	}
+	groupby, err := p.parseGroupBy()
 	if err != nil {
 		return nil, err
 	}
@@ -276,11 +801,13 @@ func (p *Parser) Parse() (*QueryTree, *ParseError) {
 	if err != nil {
 		return nil, err
 	}
-	limit, err := p.parseLimit()
+	limit, offset, err := p.parseLimit()
 	if err != nil {
 		return nil, err
 	}
-	return &QueryTree{P: prologue, S: sel, W: where, O: orderby, L: limit}, nil
+	return &QueryTree{
+		P: prologue, S: sel, C: construct, D: describe, W: where, G: groupby, O: orderby,
+		L: limit, Offset: offset}, nil
 }
 
 // Scan returns the next token from the underlying scanner.
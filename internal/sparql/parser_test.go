@@ -73,15 +73,37 @@ func TestParseSelect(t *testing.T) {
 		},
 		{
 			"SELECT DISTINCT ?name ?person",
-			&Select{[]string{"?name", "?person"}, true},
+			&Select{Variable: []string{"?name", "?person"}, Distinct: true},
 			false,
 		},
 		{
 			`SELECT ?name ?person
 			WHERE {}`,
-			&Select{[]string{"?name", "?person"}, false},
+			&Select{Variable: []string{"?name", "?person"}, Distinct: false},
 			false,
 		},
+		{
+			`SELECT ?state (COUNT(DISTINCT ?county) AS ?n) (sum(?pop) AS ?total)
+			WHERE {}`,
+			&Select{
+				Variable: []string{"?state", "?n", "?total"},
+				Aggregates: []Aggregate{
+					{Func: "COUNT", Variable: "?county", Distinct: true, Alias: "?n"},
+					{Func: "SUM", Variable: "?pop", Alias: "?total"},
+				},
+			},
+			false,
+		},
+		{
+			"SELECT (MEDIAN(?pop) AS ?m)",
+			nil,
+			true,
+		},
+		{
+			"SELECT (COUNT(?county) ?n)",
+			nil,
+			true,
+		},
 	} {
 		result, err := NewParser(strings.NewReader(c.query)).parseSelect()
 		if c.wantErr {
@@ -109,24 +131,161 @@ func TestParseWhere(t *testing.T) {
 		},
 		{
 			"Where {?person rdf:name ?name}",
-			&Where{[]Triple{Triple{"?person", "rdf:name", []string{"?name"}}}},
+			&Where{Triples: []Triple{Triple{"?person", "rdf:name", []string{"?name"}, nil}}},
 			false,
 		},
 		{
 			"Where {?person rdf:name ?name . ?person rdf:address ?address }",
-			&Where{[]Triple{
-				Triple{"?person", "rdf:name", []string{"?name"}},
-				Triple{"?person", "rdf:address", []string{"?address"}},
+			&Where{Triples: []Triple{
+				Triple{"?person", "rdf:name", []string{"?name"}, nil},
+				Triple{"?person", "rdf:address", []string{"?address"}, nil},
 			}},
 			false,
 		},
 		{
 			`Where { ?a name ("San Jose, CA" "SJ in CA") }`,
-			&Where{[]Triple{
-				Triple{"?a", "name", []string{"\"San Jose, CA\"", "\"SJ in CA\""}},
+			&Where{Triples: []Triple{
+				Triple{"?a", "name", []string{"\"San Jose, CA\"", "\"SJ in CA\""}, nil},
 			}},
 			false,
 		},
+		{
+			`Where {
+				?o value ?value .
+				?o observationDate ?date
+				FILTER(?value > 1000 && ?date >= "2010")
+			}`,
+			&Where{
+				Triples: []Triple{
+					Triple{"?o", "value", []string{"?value"}, nil},
+					Triple{"?o", "observationDate", []string{"?date"}, nil},
+				},
+				Filters: []Filter{
+					{&BinaryExpr{
+						Op:  AND,
+						LHS: &BinaryExpr{Op: GT, LHS: &VarRef{"?value"}, RHS: &NumberLiteral{1000}},
+						RHS: &BinaryExpr{Op: GTE, LHS: &VarRef{"?date"}, RHS: &StringLiteral{"2010"}},
+					}},
+				},
+			},
+			false,
+		},
+		{
+			`Where {
+				?p name ?name .
+				FILTER (!(?name = "Ohio" || ?name != ?other)) .
+			}`,
+			&Where{
+				Triples: []Triple{
+					Triple{"?p", "name", []string{"?name"}, nil},
+				},
+				Filters: []Filter{
+					{&UnaryExpr{
+						Op: NOT,
+						Expr: &BinaryExpr{
+							Op:  OR,
+							LHS: &BinaryExpr{Op: EQ, LHS: &VarRef{"?name"}, RHS: &StringLiteral{"Ohio"}},
+							RHS: &BinaryExpr{Op: NEQ, LHS: &VarRef{"?name"}, RHS: &VarRef{"?other"}},
+						},
+					}},
+				},
+			},
+			false,
+		},
+		{
+			`Where {
+				?o typeOf StatVarObservation
+				OPTIONAL { ?o unit ?unit . ?o scalingFactor ?factor }
+				OPTIONAL { ?o measurementMethod ?method }
+			}`,
+			&Where{
+				Triples: []Triple{
+					Triple{"?o", "typeOf", []string{"StatVarObservation"}, nil},
+				},
+				Optionals: []Where{
+					{Triples: []Triple{
+						Triple{"?o", "unit", []string{"?unit"}, nil},
+						Triple{"?o", "scalingFactor", []string{"?factor"}, nil},
+					}},
+					{Triples: []Triple{
+						Triple{"?o", "measurementMethod", []string{"?method"}, nil},
+					}},
+				},
+			},
+			false,
+		},
+		{
+			`Where {
+				?p containedInPlace+ country/USA .
+				?p containedInPlace/name ?name .
+				?p <https://datacommons.org/browser/containedInPlace>+ ?c .
+				?p <http://www.w3.org/1999/02/22-rdf-syntax-ns#type>/dcs:name ?n
+			}`,
+			&Where{
+				Triples: []Triple{
+					Triple{"?p", "containedInPlace+", []string{"country/USA"},
+						[]PathStep{{"containedInPlace", "+"}}},
+					Triple{"?p", "containedInPlace/name", []string{"?name"},
+						[]PathStep{{"containedInPlace", ""}, {"name", ""}}},
+					Triple{"?p", "<https://datacommons.org/browser/containedInPlace>+", []string{"?c"},
+						[]PathStep{{"<https://datacommons.org/browser/containedInPlace>", "+"}}},
+					Triple{"?p", "<http://www.w3.org/1999/02/22-rdf-syntax-ns#type>/dcs:name", []string{"?n"},
+						[]PathStep{{"<http://www.w3.org/1999/02/22-rdf-syntax-ns#type>", ""}, {"dcs:name", ""}}},
+				},
+			},
+			false,
+		},
+		{
+			`Where {
+				?p <https://schema.org/name> ?name .
+				?p typeOf dcs:City
+			}`,
+			&Where{
+				Triples: []Triple{
+					Triple{"?p", "<https://schema.org/name>", []string{"?name"}, nil},
+					Triple{"?p", "typeOf", []string{"dcs:City"}, nil},
+				},
+			},
+			false,
+		},
+		{
+			`Where {
+				?p typeOf Place .
+				{ ?p typeOf County } UNION { ?p typeOf City . ?p name ?name }
+			}`,
+			&Where{
+				Triples: []Triple{
+					Triple{"?p", "typeOf", []string{"Place"}, nil},
+				},
+				Unions: []Union{
+					{Branches: []Where{
+						{Triples: []Triple{
+							Triple{"?p", "typeOf", []string{"County"}, nil},
+						}},
+						{Triples: []Triple{
+							Triple{"?p", "typeOf", []string{"City"}, nil},
+							Triple{"?p", "name", []string{"?name"}, nil},
+						}},
+					}},
+				},
+			},
+			false,
+		},
+		{
+			"Where { ?o typeOf StatVarObservation OPTIONAL ?o unit ?unit }",
+			nil,
+			true,
+		},
+		{
+			"Where { ?p name ?name FILTER ?name = 1 }",
+			nil,
+			true,
+		},
+		{
+			"Where { ?p name ?name FILTER (?name = ) }",
+			nil,
+			true,
+		},
 	} {
 		result, err := NewParser(strings.NewReader(c.query)).parseWhere()
 		if c.wantErr {
@@ -141,10 +300,45 @@ func TestParseWhere(t *testing.T) {
 	}
 }
 
+func TestParseGroupBy(t *testing.T) {
+	for _, c := range []struct {
+		query   string
+		want    *GroupBy
+		wantErr bool
+	}{
+		{
+			"Group By 3",
+			nil,
+			true,
+		},
+		{
+			"Group By ?state ?year ORDER BY ?state",
+			&GroupBy{[]string{"?state", "?year"}},
+			false,
+		},
+		{
+			"ORDER BY ?state",
+			nil,
+			false,
+		},
+	} {
+		result, err := NewParser(strings.NewReader(c.query)).parseGroupBy()
+		if c.wantErr {
+			if err == nil {
+				t.Errorf("parseGroupBy(%s) = nil, want error", c.query)
+			}
+			continue
+		}
+		if diff := deep.Equal(c.want, result); diff != nil {
+			t.Errorf("Unexpected diff %v", diff)
+		}
+	}
+}
+
 func TestParseOrderBy(t *testing.T) {
 	for _, c := range []struct {
 		query   string
-		want    *Orderby
+		want    []*Orderby
 		wantErr bool
 	}{
 		{
@@ -154,19 +348,29 @@ func TestParseOrderBy(t *testing.T) {
 		},
 		{
 			"Order By ?name",
-			&Orderby{"?name", true},
+			[]*Orderby{{"?name", true}},
 			false,
 		},
 		{
 			"Order By ASC(?age)",
-			&Orderby{"?age", true},
+			[]*Orderby{{"?age", true}},
 			false,
 		},
 		{
 			"Order By DESC(?pop)",
-			&Orderby{"?pop", false},
+			[]*Orderby{{"?pop", false}},
 			false,
 		},
+		{
+			"Order By DESC(?value) ?name ASC(?date) LIMIT 10",
+			[]*Orderby{{"?value", false}, {"?name", true}, {"?date", true}},
+			false,
+		},
+		{
+			"Order By DESC(?value) ASC ?name",
+			nil,
+			true,
+		},
 	} {
 		result, err := NewParser(strings.NewReader(c.query)).parseOrderBy()
 		if c.wantErr {
@@ -183,35 +387,66 @@ func TestParseOrderBy(t *testing.T) {
 
 func TestParseLimit(t *testing.T) {
 	for _, c := range []struct {
-		query   string
-		want    int
-		wantErr bool
+		query      string
+		wantLimit  int
+		wantOffset int
+		wantErr    bool
 	}{
 		{
 			"Order By ?name",
 			0,
+			0,
 			true,
 		},
 		{
 			"LIMIT 30.2",
 			0,
+			0,
 			true,
 		},
 		{
 			"LIMIT 10",
 			10,
+			0,
 			false,
 		},
+		{
+			"OFFSET 20",
+			0,
+			20,
+			false,
+		},
+		{
+			"LIMIT 10 OFFSET 20",
+			10,
+			20,
+			false,
+		},
+		{
+			"OFFSET 20 LIMIT 10",
+			10,
+			20,
+			false,
+		},
+		{
+			"LIMIT 10 LIMIT 20",
+			0,
+			0,
+			true,
+		},
 	} {
-		result, err := NewParser(strings.NewReader(c.query)).parseLimit()
+		limit, offset, err := NewParser(strings.NewReader(c.query)).parseLimit()
 		if c.wantErr {
 			if err == nil {
 				t.Errorf("parseLimit(%s) = nil, want error", c.query)
 			}
 			continue
 		}
-		if diff := deep.Equal(c.want, result); diff != nil {
-			t.Errorf("Unexpected diff %v", diff)
+		if diff := deep.Equal(c.wantLimit, limit); diff != nil {
+			t.Errorf("Unexpected limit diff %v", diff)
+		}
+		if diff := deep.Equal(c.wantOffset, offset); diff != nil {
+			t.Errorf("Unexpected offset diff %v", diff)
 		}
 	}
 }
@@ -235,12 +470,12 @@ func TestParseTree(t *testing.T) {
 			`,
 			&QueryTree{
 				P: &Prologue{Base: "<http://schema.org/>", Prefix: map[string]string{}},
-				S: &Select{[]string{"?dcid"}, true},
-				W: &Where{[]Triple{
-					Triple{"?p", "typeOf", []string{"Place"}},
-					Triple{"?p", "subType", []string{"City"}},
-					Triple{"?p", "name", []string{"\"San Jose\""}},
-					Triple{"?p", "dcid", []string{"?dcid"}},
+				S: &Select{Variable: []string{"?dcid"}, Distinct: true},
+				W: &Where{Triples: []Triple{
+					Triple{"?p", "typeOf", []string{"Place"}, nil},
+					Triple{"?p", "subType", []string{"City"}, nil},
+					Triple{"?p", "name", []string{"\"San Jose\""}, nil},
+					Triple{"?p", "dcid", []string{"?dcid"}, nil},
 				}},
 				L: 20,
 			},
@@ -256,15 +491,72 @@ func TestParseTree(t *testing.T) {
 			`,
 			&QueryTree{
 				P: &Prologue{Base: "<http://schema.org/>", Prefix: map[string]string{}},
-				S: &Select{[]string{"?a"}, false},
-				W: &Where{[]Triple{
-					Triple{"?a", "name", []string{"\"San Jose, CA\"", "\"SJ in CA\""}},
+				S: &Select{Variable: []string{"?a"}, Distinct: false},
+				W: &Where{Triples: []Triple{
+					Triple{"?a", "name", []string{"\"San Jose, CA\"", "\"SJ in CA\""}, nil},
 				}},
-				O: &Orderby{"?a", true},
+				O: []*Orderby{{"?a", true}},
 				L: 10,
 			},
 			false,
 		},
+		{
+			`CONSTRUCT { ?p containedInPlace ?parent . ?p name ?name }
+			 WHERE {
+			 	?p typeOf City .
+				?p containedInPlace ?parent .
+				?p name ?name
+			 }
+			`,
+			&QueryTree{
+				P: &Prologue{Prefix: map[string]string{}},
+				S: &Select{Variable: []string{"?p", "?parent", "?name"}},
+				C: &Construct{Triples: []Triple{
+					Triple{"?p", "containedInPlace", []string{"?parent"}, nil},
+					Triple{"?p", "name", []string{"?name"}, nil},
+				}},
+				W: &Where{Triples: []Triple{
+					Triple{"?p", "typeOf", []string{"City"}, nil},
+					Triple{"?p", "containedInPlace", []string{"?parent"}, nil},
+					Triple{"?p", "name", []string{"?name"}, nil},
+				}},
+			},
+			false,
+		},
+		{
+			"DESCRIBE geoId/06 <https://datacommons.org/browser/geoId/08>",
+			&QueryTree{
+				P: &Prologue{Prefix: map[string]string{}},
+				S: &Select{},
+				D: &Describe{Resources: []string{
+					"geoId/06", "<https://datacommons.org/browser/geoId/08>"}},
+				W: &Where{},
+			},
+			false,
+		},
+		{
+			`DESCRIBE ?p WHERE { ?p typeOf State } LIMIT 5`,
+			&QueryTree{
+				P: &Prologue{Prefix: map[string]string{}},
+				S: &Select{Variable: []string{"?p"}},
+				D: &Describe{Resources: []string{"?p"}},
+				W: &Where{Triples: []Triple{
+					Triple{"?p", "typeOf", []string{"State"}, nil},
+				}},
+				L: 5,
+			},
+			false,
+		},
+		{
+			`ASK { ?p typeOf State }`,
+			nil,
+			true,
+		},
+		{
+			`CONSTRUCT { ?p name ?name FILTER(?name = "a") } WHERE { ?p name ?name }`,
+			nil,
+			true,
+		},
 	} {
 		result, err := NewParser(strings.NewReader(c.query)).Parse()
 		if c.wantErr {
@@ -0,0 +1,68 @@
+// Copyright 2019 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sparql
+
+import (
+	"fmt"
+
+	"github.com/datacommonsorg/mixer/internal/base"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// isPath checks if a triple has a property path predicate.
+func isPath(t *Triple) bool {
+	return len(t.Path) > 0
+}
+
+// pathQueries converts a triple with property path to a chain of query
+// statements connected by intermediate nodes. A "+" step is a transitive
+// statement, which the translator expands to the numbers of repetitions the
+// type hierarchy allows.
+func pathQueries(t *Triple, optional bool, newNode func() string) (
+	[]*base.Query, error) {
+	queries := []*base.Query{}
+	sub := t.Sub
+	for i, step := range t.Path {
+		if step.Pred == "" || (step.Modifier != "" && step.Modifier != "+") {
+			return nil, status.Errorf(
+				codes.InvalidArgument, "Unsupported property path %s", t.Pred)
+		}
+		var query *base.Query
+		if i == len(t.Path)-1 {
+			query = toQuery(step.Pred, sub, t.Objs)
+		} else {
+			obj := newNode()
+			query = base.NewQuery(step.Pred, sub, base.NewNode(obj))
+			sub = obj
+		}
+		query.Optional = optional
+		query.Transitive = step.Modifier == "+"
+		queries = append(queries, query)
+	}
+	return queries, nil
+}
+
+// pathNodeGenerator returns a function that generates unique intermediate
+// nodes for property paths.
+func pathNodeGenerator() func() string {
+	count := 0
+	return func() string {
+		alias := fmt.Sprintf("?_path%d", count)
+		count++
+		return alias
+	}
+}
@@ -0,0 +1,78 @@
+// Copyright 2019 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sparql
+
+import (
+	"testing"
+
+	"github.com/datacommonsorg/mixer/internal/base"
+	"github.com/go-test/deep"
+)
+
+func TestPathQueries(t *testing.T) {
+	for _, c := range []struct {
+		query   string
+		want    []*base.Query
+		wantErr bool
+	}{
+		{
+			`SELECT ?name WHERE { ?p containedInPlace/name ?name }`,
+			[]*base.Query{
+				base.NewQuery("containedInPlace", "?p", base.NewNode("?_path0")),
+				base.NewQuery("name", "?_path0", base.NewNode("?name")),
+			},
+			false,
+		},
+		{
+			`SELECT ?p WHERE { ?p <https://datacommons.org/browser/containedInPlace>+ country/USA }`,
+			[]*base.Query{
+				{Pred: "containedInPlace", Sub: base.NewNode("?p"), Obj: "country/USA", Transitive: true},
+			},
+			false,
+		},
+		{
+			`SELECT ?t WHERE { ?p <http://www.w3.org/1999/02/22-rdf-syntax-ns#type> ?t }`,
+			[]*base.Query{
+				base.NewQuery("http://www.w3.org/1999/02/22-rdf-syntax-ns#type", "?p", base.NewNode("?t")),
+			},
+			false,
+		},
+		{
+			`SELECT ?p WHERE { ?p containedInPlace* country/USA }`,
+			nil,
+			true,
+		},
+		{
+			`SELECT ?p WHERE { ?p containedInPlace//name ?name }`,
+			nil,
+			true,
+		},
+	} {
+		_, got, _, err := ParseQuery(c.query)
+		if c.wantErr {
+			if err == nil {
+				t.Errorf("ParseQuery(%s) = nil, want error", c.query)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("ParseQuery(%s) = %s", c.query, err)
+			continue
+		}
+		if diff := deep.Equal(c.want, got); diff != nil {
+			t.Errorf("ParseQuery(%s) unexpected diff %v", c.query, diff)
+		}
+	}
+}
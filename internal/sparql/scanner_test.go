@@ -47,8 +47,18 @@ func TestScanSimple(t *testing.T) {
 		{s: `OR`, tok: OR},
 		{s: `or`, tok: OR},
 
+		{s: `&&`, tok: AND},
+		{s: `||`, tok: OR},
+		{s: `!`, tok: NOT},
+		{s: `& `, tok: ILLEGAL, lit: "&"},
+
+		// Comparison operators
 		{s: `=`, tok: EQ},
-		{s: `! `, tok: ILLEGAL, lit: "!"},
+		{s: `!=`, tok: NEQ},
+		{s: `<`, tok: LT, lit: "<"},
+		{s: `<=`, tok: LTE},
+		{s: `>`, tok: GT, lit: ">"},
+		{s: `>=`, tok: GTE},
 
 		// Misc tokens
 		{s: `(`, tok: LPAREN},
@@ -88,15 +98,22 @@ func TestScanSimple(t *testing.T) {
 		{s: `10.3s`, tok: NUMBER, lit: `10.3`},
 
 		// Keywords
+		{s: `AS`, tok: AS},
 		{s: `BASE`, tok: BASE},
 		{s: `BY`, tok: BY},
+		{s: `CONSTRUCT`, tok: CONSTRUCT},
+		{s: `DESCRIBE`, tok: DESCRIBE},
 		{s: `FILTER`, tok: FILTER},
 		{s: `FROM`, tok: FROM},
+		{s: `GROUP`, tok: GROUP},
 		{s: `IN`, tok: IN},
 		{s: `LIMIT`, tok: LIMIT},
+		{s: `OFFSET`, tok: OFFSET},
+		{s: `OPTIONAL`, tok: OPTIONAL},
 		{s: `ORDER`, tok: ORDER},
 		{s: `PREFIX`, tok: PREFIX},
 		{s: `SELECT`, tok: SELECT},
+		{s: `UNION`, tok: UNION},
 		{s: `WHERE`, tok: WHERE},
 		{s: `seLECT`, tok: SELECT}, // case insensitive
 	}
@@ -16,10 +16,12 @@
 package sparql
 
 import (
+	"strconv"
 	"strings"
 
 	"github.com/datacommonsorg/mixer/internal/base"
 
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
@@ -28,34 +30,208 @@ import (
 func ParseQuery(queryString string) ([]base.Node, []*base.Query, *base.QueryOptions, error) {
 	queryTree, err := NewParser(strings.NewReader(queryString)).Parse()
 	if err != nil {
-		return nil, nil, nil, status.Errorf(
-			codes.InvalidArgument, "Invalid sparql query string\n%s", queryString)
+		return nil, nil, nil, parseErrorStatus(err)
 	}
-	opts := base.QueryOptions{Limit: queryTree.L, Distinct: queryTree.S.Distinct}
+	opts := base.QueryOptions{
+		Limit: queryTree.L, Offset: queryTree.Offset, Distinct: queryTree.S.Distinct}
 
 	nodes := []base.Node{}
 	for _, v := range queryTree.S.Variable {
 		nodes = append(nodes, base.NewNode(v))
 	}
 
+	resolver, qErr := newIRIResolver(queryTree.P)
+	if qErr != nil {
+		return nil, nil, nil, qErr
+	}
+	if qErr = resolver.resolveWhere(queryTree.W); qErr != nil {
+		return nil, nil, nil, qErr
+	}
+	if queryTree.C != nil {
+		template := &Where{Triples: queryTree.C.Triples}
+		if qErr = resolver.resolveWhere(template); qErr != nil {
+			return nil, nil, nil, qErr
+		}
+		opts.Form = base.Construct
+		for _, t := range template.Triples {
+			if isPath(&t) {
+				return nil, nil, nil, status.Errorf(
+					codes.InvalidArgument, "Property path in CONSTRUCT template: %s", t.Pred)
+			}
+			opts.Template = append(opts.Template, toQuery(t.Pred, t.Sub, t.Objs))
+		}
+	}
+	if queryTree.D != nil {
+		opts.Form = base.Describe
+		for _, r := range queryTree.D.Resources {
+			if strings.HasPrefix(r, "?") {
+				continue
+			}
+			resolved, err := resolver.resolve(r)
+			if err != nil {
+				return nil, nil, nil, err
+			}
+			opts.Describe = append(opts.Describe, resolved)
+		}
+	}
+	alternatives, qErr := toQueries(queryTree.W, false, pathNodeGenerator())
+	if qErr != nil {
+		return nil, nil, nil, qErr
+	}
+	queries := []*base.Query{}
+	for i, alternative := range alternatives {
+		for _, q := range alternative {
+			// Statements can be shared by alternatives, so make a copy.
+			query := *q
+			query.Branch = i
+			queries = append(queries, &query)
+		}
+	}
+	for _, f := range queryTree.W.Filters {
+		filter, ok := toFilter(f.Expr).(*base.Filter)
+		if !ok {
+			return nil, nil, nil, status.Errorf(
+				codes.InvalidArgument, "FILTER is not a boolean expression: %s", f.Expr)
+		}
+		opts.Filters = append(opts.Filters, filter)
+	}
+	for _, a := range queryTree.S.Aggregates {
+		opts.Aggregates = append(opts.Aggregates, &base.Aggregate{
+			Func:     a.Func,
+			Node:     base.NewNode(a.Variable),
+			Distinct: a.Distinct,
+			Alias:    base.NewNode(a.Alias),
+		})
+	}
+	if queryTree.G != nil {
+		for _, v := range queryTree.G.Variable {
+			opts.GroupBy = append(opts.GroupBy, base.NewNode(v))
+		}
+	}
+	for _, o := range queryTree.O {
+		opts.Orderby = append(opts.Orderby, &base.Orderby{Node: base.NewNode(o.Variable), ASC: o.ASC})
+	}
+	return nodes, queries, &opts, nil
+}
+
+// parseErrorStatus converts a parse error to an InvalidArgument error, with
+// the error position, the found token and the expected tokens attached as
+// error details.
+func parseErrorStatus(err *ParseError) error {
+	st := status.New(codes.InvalidArgument, "Invalid sparql query string: "+err.Error())
+	detailed, detailErr := st.WithDetails(
+		&errdetails.BadRequest{
+			FieldViolations: []*errdetails.BadRequest_FieldViolation{
+				{Field: "sparql", Description: err.Error()},
+			},
+		},
+		&errdetails.ErrorInfo{
+			Reason: "SPARQL_PARSE_ERROR",
+			Domain: "datacommons.org",
+			Metadata: map[string]string{
+				"line":     strconv.Itoa(err.Pos.Line + 1),
+				"column":   strconv.Itoa(err.Pos.Char + 1),
+				"found":    err.Found,
+				"expected": strings.Join(err.Expected, ","),
+			},
+		},
+	)
+	if detailErr != nil {
+		return st.Err()
+	}
+	return detailed.Err()
+}
+
+// toQuery converts a triple to a query statement.
+func toQuery(pred, sub string, objs []string) *base.Query {
+	if len(objs) == 1 {
+		obj := objs[0]
+		if strings.HasPrefix(obj, "?") {
+			return base.NewQuery(pred, sub, base.NewNode(obj))
+		}
+		return base.NewQuery(pred, sub, obj)
+	}
+	return base.NewQuery(pred, sub, objs)
+}
+
+// toQueries converts a graph pattern to alternatives of query statements.
+// Each UNION pattern multiplies the alternatives by its number of branches.
+func toQueries(w *Where, optional bool, newNode func() string) (
+	[][]*base.Query, error) {
+	if optional && len(w.Filters) > 0 {
+		return nil, status.Error(
+			codes.InvalidArgument, "FILTER inside OPTIONAL pattern is not supported")
+	}
 	queries := []*base.Query{}
-	for _, t := range queryTree.W.Triples {
-		var query *base.Query
-		if len(t.Objs) == 1 {
-			obj := t.Objs[0]
-			if strings.HasPrefix(obj, "?") {
-				query = base.NewQuery(t.Pred, t.Sub, base.NewNode(obj))
-			} else {
-				query = base.NewQuery(t.Pred, t.Sub, obj)
+	for i, t := range w.Triples {
+		if isPath(&w.Triples[i]) {
+			chain, err := pathQueries(&w.Triples[i], optional, newNode)
+			if err != nil {
+				return nil, err
 			}
-		} else {
-			query = base.NewQuery(t.Pred, t.Sub, t.Objs)
+			queries = append(queries, chain...)
+			continue
 		}
+		query := toQuery(t.Pred, t.Sub, t.Objs)
+		query.Optional = optional
 		queries = append(queries, query)
 	}
-	if queryTree.O != nil {
-		opts.Orderby = queryTree.O.Variable
-		opts.ASC = queryTree.O.ASC
+	result := [][]*base.Query{queries}
+	for i := range w.Optionals {
+		alternatives, err := toQueries(&w.Optionals[i], true, newNode)
+		if err != nil {
+			return nil, err
+		}
+		result = crossQueries(result, alternatives)
 	}
-	return nodes, queries, &opts, nil
+	for _, u := range w.Unions {
+		alternatives := [][]*base.Query{}
+		for i := range u.Branches {
+			if len(u.Branches[i].Filters) > 0 {
+				return nil, status.Error(
+					codes.InvalidArgument, "FILTER inside UNION pattern is not supported")
+			}
+			branch, err := toQueries(&u.Branches[i], optional, newNode)
+			if err != nil {
+				return nil, err
+			}
+			alternatives = append(alternatives, branch...)
+		}
+		result = crossQueries(result, alternatives)
+	}
+	return result, nil
+}
+
+// crossQueries obtains the Cartesian product of two alternatives of query
+// statements.
+func crossQueries(left, right [][]*base.Query) [][]*base.Query {
+	result := [][]*base.Query{}
+	for _, l := range left {
+		for _, r := range right {
+			queries := append(append([]*base.Query{}, l...), r...)
+			result = append(result, queries)
+		}
+	}
+	return result
+}
+
+// toFilter converts a filter expression to a base.Filter or one of its operands.
+func toFilter(e Expr) interface{} {
+	switch v := e.(type) {
+	case *BinaryExpr:
+		return &base.Filter{Op: v.Op.String(), Args: []interface{}{toFilter(v.LHS), toFilter(v.RHS)}}
+	case *UnaryExpr:
+		return &base.Filter{Op: "NOT", Args: []interface{}{toFilter(v.Expr)}}
+	case *VarRef:
+		return base.NewNode(v.Val)
+	case *StringLiteral:
+		return v.Val
+	case *IRIRef:
+		return v.Val
+	case *NumberLiteral:
+		return v.Val
+	case *BooleanLiteral:
+		return v.Val
+	}
+	return nil
 }
@@ -0,0 +1,123 @@
+// Copyright 2019 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sparql
+
+import (
+	"testing"
+
+	"github.com/datacommonsorg/mixer/internal/base"
+	"github.com/go-test/deep"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestParseQueryError(t *testing.T) {
+	for _, c := range []struct {
+		query         string
+		wantViolation string
+		wantMetadata  map[string]string
+	}{
+		{
+			"SELECT ?name\nWHERE {\n  ?p name ?name .\n}\nLIMIT ?n",
+			"found ?n, expected NUMBER at line 5, char 7",
+			map[string]string{
+				"line":     "5",
+				"column":   "7",
+				"found":    "?n",
+				"expected": "NUMBER",
+			},
+		},
+		{
+			"SELECT ?name WHERE { ?p name ?name } ORDER BY 3",
+			"found 3, expected ?... at line 1, char 47",
+			map[string]string{
+				"line":     "1",
+				"column":   "47",
+				"found":    "3",
+				"expected": "?...",
+			},
+		},
+	} {
+		_, _, _, err := ParseQuery(c.query)
+		st, ok := status.FromError(err)
+		if !ok || st.Code() != codes.InvalidArgument {
+			t.Errorf("ParseQuery(%s) = %v, want InvalidArgument", c.query, err)
+			continue
+		}
+		var violation string
+		var metadata map[string]string
+		for _, detail := range st.Details() {
+			switch d := detail.(type) {
+			case *errdetails.BadRequest:
+				violation = d.GetFieldViolations()[0].GetDescription()
+			case *errdetails.ErrorInfo:
+				metadata = d.GetMetadata()
+			}
+		}
+		if diff := deep.Equal(c.wantViolation, violation); diff != nil {
+			t.Errorf("ParseQuery(%s) unexpected violation diff %v", c.query, diff)
+		}
+		if diff := deep.Equal(c.wantMetadata, metadata); diff != nil {
+			t.Errorf("ParseQuery(%s) unexpected metadata diff %v", c.query, diff)
+		}
+	}
+}
+
+func TestParseQueryForm(t *testing.T) {
+	for _, c := range []struct {
+		query        string
+		wantForm     string
+		wantTemplate []*base.Query
+		wantDescribe []string
+	}{
+		{
+			`SELECT ?name WHERE { ?p name ?name }`,
+			"",
+			nil,
+			nil,
+		},
+		{
+			`CONSTRUCT { ?p dcs:name ?name . ?p source "census" } WHERE { ?p name ?name }`,
+			base.Construct,
+			[]*base.Query{
+				base.NewQuery("name", "?p", base.NewNode("?name")),
+				base.NewQuery("source", "?p", `"census"`),
+			},
+			nil,
+		},
+		{
+			`DESCRIBE dcid:geoId/06 ?p WHERE { ?p containedInPlace geoId/06 }`,
+			base.Describe,
+			nil,
+			[]string{"geoId/06"},
+		},
+	} {
+		_, _, opts, err := ParseQuery(c.query)
+		if err != nil {
+			t.Errorf("ParseQuery(%s) = %s", c.query, err)
+			continue
+		}
+		if diff := deep.Equal(c.wantForm, opts.Form); diff != nil {
+			t.Errorf("ParseQuery(%s) unexpected form diff %v", c.query, diff)
+		}
+		if diff := deep.Equal(c.wantTemplate, opts.Template); diff != nil {
+			t.Errorf("ParseQuery(%s) unexpected template diff %v", c.query, diff)
+		}
+		if diff := deep.Equal(c.wantDescribe, opts.Describe); diff != nil {
+			t.Errorf("ParseQuery(%s) unexpected describe diff %v", c.query, diff)
+		}
+	}
+}
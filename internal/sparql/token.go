@@ -42,10 +42,14 @@ const (
 	// AND and following are Sparql operators.
 	AND // AND
 	OR  // OR
+	NOT // !
 	EQ  // =
+	NEQ // !=
 
 	LT        // <
+	LTE       // <=
 	GT        // >
+	GTE       // >=
 	LPAREN    // (
 	RPAREN    // )
 	LBRAC     // {
@@ -56,19 +60,26 @@ const (
 	HASH      // #
 
 	keywordBeg
-	// ASC and following are Sparql keywords.
+	// AS and following are Sparql keywords.
+	AS
 	ASC
 	BASE
 	BY
+	CONSTRUCT
 	DESC
+	DESCRIBE
 	DISTINCT
 	FILTER
 	FROM
+	GROUP
 	IN
 	LIMIT
+	OFFSET
+	OPTIONAL
 	ORDER
 	PREFIX
 	SELECT
+	UNION
 	WHERE
 	keywordEnd
 )
@@ -91,11 +102,15 @@ var (
 
 		AND: "AND",
 		OR:  "OR",
+		NOT: "!",
 
-		EQ: "=",
+		EQ:  "=",
+		NEQ: "!=",
 
 		LT:        "<",
+		LTE:       "<=",
 		GT:        ">",
+		GTE:       ">=",
 		LPAREN:    "(",
 		RPAREN:    ")",
 		LBRAC:     "{",
@@ -105,19 +120,26 @@ var (
 		DOT:       ".",
 		HASH:      ".",
 
-		ASC:      "ASC",
-		BASE:     "BASE",
-		BY:       "BY",
-		DESC:     "DESC",
-		DISTINCT: "DISTINCT",
-		FILTER:   "FILTER",
-		FROM:     "FROM",
-		IN:       "IN",
-		LIMIT:    "LIMIT",
-		ORDER:    "ORDER",
-		PREFIX:   "PREFIX",
-		SELECT:   "SELECT",
-		WHERE:    "WHERE",
+		AS:        "AS",
+		ASC:       "ASC",
+		BASE:      "BASE",
+		BY:        "BY",
+		CONSTRUCT: "CONSTRUCT",
+		DESC:      "DESC",
+		DESCRIBE:  "DESCRIBE",
+		DISTINCT:  "DISTINCT",
+		FILTER:    "FILTER",
+		FROM:      "FROM",
+		GROUP:     "GROUP",
+		IN:        "IN",
+		LIMIT:     "LIMIT",
+		OFFSET:    "OFFSET",
+		OPTIONAL:  "OPTIONAL",
+		ORDER:     "ORDER",
+		PREFIX:    "PREFIX",
+		SELECT:    "SELECT",
+		UNION:     "UNION",
+		WHERE:     "WHERE",
 	}
 
 	keywords map[string]Token
@@ -150,6 +172,8 @@ func (tok Token) Precedence() int {
 		return 1
 	case AND:
 		return 2
+	case EQ, NEQ, LT, LTE, GT, GTE:
+		return 3
 	}
 	return 0
 }
@@ -0,0 +1,206 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package store
+
+import (
+	"fmt"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// DefaultBranchLayer is the name of the branch cache layer updated by the
+// branch cache Pub/Sub subscriber.
+const DefaultBranchLayer = "default"
+
+// BranchLayer is a branch cache table stacked on the base cache. Rows in a
+// layer are merged over the rows in the base cache and lower layers.
+type BranchLayer struct {
+	// Name identifies the layer, like the team that publishes it.
+	Name string
+	// Table is the name of the table, for reporting.
+	Table string
+	// Reader reads the rows of the table.
+	Reader KVReader
+	// Version is the version of the table in the row cache.
+	Version string
+	// Previous is the layer replaced by the last update, kept for rollback.
+	Previous *BranchLayer
+}
+
+// BranchLayers gets the branch cache layers, from the lowest to the highest
+// priority.
+func (st *Store) BranchLayers() []BranchLayer {
+	st.branchLock.RLock()
+	defer st.branchLock.RUnlock()
+	result := make([]BranchLayer, len(st.branchLayers))
+	for i, l := range st.branchLayers {
+		result[i] = *l
+	}
+	return result
+}
+
+// newBranchLayer creates a layer with a new row cache version. It must be
+// called with branchLock held.
+func (st *Store) newBranchLayer(name, table string, reader KVReader) *BranchLayer {
+	st.branchGen++
+	return &BranchLayer{
+		Name:    name,
+		Table:   table,
+		Reader:  reader,
+		Version: fmt.Sprintf("branch-%d", st.branchGen),
+	}
+}
+
+func (st *Store) findBranchLayer(name string) int {
+	for i, l := range st.branchLayers {
+		if l.Name == name {
+			return i
+		}
+	}
+	return -1
+}
+
+// AddBranchLayer adds a branch cache layer with the highest priority.
+func (st *Store) AddBranchLayer(name, table string, reader KVReader) error {
+	if name == "" || reader == nil {
+		return status.Errorf(codes.InvalidArgument, "Missing branch cache name or table")
+	}
+	st.branchLock.Lock()
+	defer st.branchLock.Unlock()
+	if st.findBranchLayer(name) >= 0 {
+		return status.Errorf(codes.AlreadyExists, "Branch cache %s already exists", name)
+	}
+	st.branchLayers = append(st.branchLayers, st.newBranchLayer(name, table, reader))
+	return nil
+}
+
+// UpdateBranchLayer replaces the table of a branch cache layer, keeping its
+// priority and the replaced table for rollback. The layer is added with the
+// lowest priority if it does not exist.
+func (st *Store) UpdateBranchLayer(name, table string, reader KVReader) {
+	if reader == nil {
+		// Not found is fine for removing a layer that was never set.
+		_ = st.RemoveBranchLayer(name)
+		return
+	}
+	st.branchLock.Lock()
+	defer st.branchLock.Unlock()
+	if i := st.findBranchLayer(name); i >= 0 {
+		st.replaceBranchLayer(i, table, reader)
+	} else {
+		layer := st.newBranchLayer(name, table, reader)
+		st.branchLayers = append([]*BranchLayer{layer}, st.branchLayers...)
+	}
+}
+
+// ReplaceBranchLayer replaces the table of an existing branch cache layer,
+// keeping its priority and the replaced table for rollback.
+func (st *Store) ReplaceBranchLayer(name, table string, reader KVReader) error {
+	if name == "" || reader == nil {
+		return status.Errorf(codes.InvalidArgument, "Missing branch cache name or table")
+	}
+	st.branchLock.Lock()
+	defer st.branchLock.Unlock()
+	i := st.findBranchLayer(name)
+	if i < 0 {
+		return status.Errorf(codes.NotFound, "Branch cache %s not found", name)
+	}
+	st.replaceBranchLayer(i, table, reader)
+	return nil
+}
+
+// replaceBranchLayer replaces the table of the i-th layer. The rows of the
+// replaced table are removed from the row cache before the lock is released,
+// so that no reader sees the new layers with the old rows. It must be called
+// with branchLock held.
+func (st *Store) replaceBranchLayer(i int, table string, reader KVReader) {
+	previous := *st.branchLayers[i]
+	previous.Previous = nil
+	layer := st.newBranchLayer(previous.Name, table, reader)
+	layer.Previous = &previous
+	st.branchLayers[i] = layer
+	st.rowCache.RemoveVersion(previous.Version)
+}
+
+// RollbackBranchLayer swaps the table of a branch cache layer with the table
+// it replaced in the last update. Another rollback undoes the rollback.
+func (st *Store) RollbackBranchLayer(name string) error {
+	st.branchLock.Lock()
+	defer st.branchLock.Unlock()
+	i := st.findBranchLayer(name)
+	if i < 0 {
+		return status.Errorf(codes.NotFound, "Branch cache %s not found", name)
+	}
+	current := *st.branchLayers[i]
+	if current.Previous == nil {
+		return status.Errorf(
+			codes.FailedPrecondition, "Branch cache %s has no previous table", name)
+	}
+	layer := *current.Previous
+	current.Previous = nil
+	layer.Previous = &current
+	st.branchLayers[i] = &layer
+	// The rows of a table version stay valid, so only memory is released.
+	st.rowCache.RemoveVersion(current.Version)
+	return nil
+}
+
+// UpdateBranchBt updates the table of the default branch cache layer, and
+// removes the layer for nil table.
+func (st *Store) UpdateBranchBt(branchTable KVReader) {
+	st.UpdateBranchLayer(DefaultBranchLayer, "", branchTable)
+}
+
+// RemoveBranchLayer removes a branch cache layer.
+func (st *Store) RemoveBranchLayer(name string) error {
+	st.branchLock.Lock()
+	defer st.branchLock.Unlock()
+	i := st.findBranchLayer(name)
+	if i < 0 {
+		return status.Errorf(codes.NotFound, "Branch cache %s not found", name)
+	}
+	version := st.branchLayers[i].Version
+	layers := append([]*BranchLayer{}, st.branchLayers[:i]...)
+	st.branchLayers = append(layers, st.branchLayers[i+1:]...)
+	st.rowCache.RemoveVersion(version)
+	return nil
+}
+
+// ReorderBranchLayers sets the priority of the branch cache layers. The names
+// are all the layer names, from the lowest to the highest priority.
+func (st *Store) ReorderBranchLayers(names []string) error {
+	st.branchLock.Lock()
+	defer st.branchLock.Unlock()
+	if len(names) != len(st.branchLayers) {
+		return status.Errorf(codes.InvalidArgument,
+			"Got %d branch cache names, want all %d", len(names), len(st.branchLayers))
+	}
+	layers := make([]*BranchLayer, 0, len(names))
+	seen := map[string]bool{}
+	for _, name := range names {
+		i := st.findBranchLayer(name)
+		if i < 0 {
+			return status.Errorf(codes.NotFound, "Branch cache %s not found", name)
+		}
+		if seen[name] {
+			return status.Errorf(codes.InvalidArgument, "Duplicate branch cache %s", name)
+		}
+		seen[name] = true
+		layers = append(layers, st.branchLayers[i])
+	}
+	st.branchLayers = layers
+	return nil
+}
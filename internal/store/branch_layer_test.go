@@ -0,0 +1,114 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package store
+
+import (
+	"testing"
+
+	"github.com/go-test/deep"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func layerNames(st *Store) []string {
+	names := []string{}
+	for _, l := range st.BranchLayers() {
+		names = append(names, l.Name+":"+l.Table)
+	}
+	return names
+}
+
+func TestBranchLayers(t *testing.T) {
+	st := NewStore(nil, nil, nil)
+	reader := NewMemoryReader(map[string]string{})
+	if err := st.AddBranchLayer("a", "table_a", reader); err != nil {
+		t.Fatalf("AddBranchLayer(a) = %s", err)
+	}
+	if err := st.AddBranchLayer("b", "table_b", reader); err != nil {
+		t.Fatalf("AddBranchLayer(b) = %s", err)
+	}
+	// The default layer is added with the lowest priority.
+	st.UpdateBranchBt(reader)
+	st.UpdateBranchLayer("a", "table_a2", reader)
+	want := []string{"default:", "a:table_a2", "b:table_b"}
+	if diff := deep.Equal(want, layerNames(st)); diff != nil {
+		t.Errorf("BranchLayers() unexpected diff %v", diff)
+	}
+
+	if err := st.ReorderBranchLayers([]string{"b", "default", "a"}); err != nil {
+		t.Fatalf("ReorderBranchLayers() = %s", err)
+	}
+	if err := st.RemoveBranchLayer("default"); err != nil {
+		t.Fatalf("RemoveBranchLayer() = %s", err)
+	}
+	want = []string{"b:table_b", "a:table_a2"}
+	if diff := deep.Equal(want, layerNames(st)); diff != nil {
+		t.Errorf("BranchLayers() unexpected diff %v", diff)
+	}
+
+	for _, c := range []struct {
+		err  error
+		code codes.Code
+	}{
+		{st.AddBranchLayer("a", "table", reader), codes.AlreadyExists},
+		{st.AddBranchLayer("", "table", reader), codes.InvalidArgument},
+		{st.RemoveBranchLayer("c"), codes.NotFound},
+		{st.ReplaceBranchLayer("c", "table", reader), codes.NotFound},
+		{st.ReplaceBranchLayer("a", "table", nil), codes.InvalidArgument},
+		{st.ReorderBranchLayers([]string{"a"}), codes.InvalidArgument},
+		{st.ReorderBranchLayers([]string{"a", "a"}), codes.InvalidArgument},
+		{st.ReorderBranchLayers([]string{"a", "c"}), codes.NotFound},
+	} {
+		if got := status.Code(c.err); got != c.code {
+			t.Errorf("got error code %s (%v), want %s", got, c.err, c.code)
+		}
+	}
+	// Failed reorder keeps the layers.
+	if diff := deep.Equal(want, layerNames(st)); diff != nil {
+		t.Errorf("BranchLayers() unexpected diff %v", diff)
+	}
+}
+
+func TestRollbackBranchLayer(t *testing.T) {
+	st := NewStore(nil, nil, nil)
+	reader := NewMemoryReader(map[string]string{})
+	st.UpdateBranchLayer("a", "table_1", reader)
+	if err := st.RollbackBranchLayer("a"); status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("RollbackBranchLayer() = %v, want FailedPrecondition", err)
+	}
+	st.UpdateBranchLayer("a", "table_2", reader)
+	if err := st.ReplaceBranchLayer("a", "table_3", reader); err != nil {
+		t.Fatalf("ReplaceBranchLayer() = %s", err)
+	}
+	for _, c := range []struct {
+		table    string
+		previous string
+	}{
+		{"table_2", "table_3"},
+		{"table_3", "table_2"},
+	} {
+		if err := st.RollbackBranchLayer("a"); err != nil {
+			t.Fatalf("RollbackBranchLayer() = %s", err)
+		}
+		l := st.BranchLayers()[0]
+		if l.Table != c.table || l.Previous == nil || l.Previous.Table != c.previous {
+			t.Errorf("RollbackBranchLayer() got layer %+v, want table %s, previous %s",
+				l, c.table, c.previous)
+		}
+	}
+	if err := st.RollbackBranchLayer("b"); status.Code(err) != codes.NotFound {
+		t.Errorf("RollbackBranchLayer() = %v, want NotFound", err)
+	}
+}
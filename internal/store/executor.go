@@ -0,0 +1,217 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package store
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+	"time"
+
+	"cloud.google.com/go/bigquery"
+	"cloud.google.com/go/civil"
+	"github.com/datacommonsorg/mixer/internal/base"
+	"google.golang.org/api/iterator"
+)
+
+// QueryExecutor runs SQL queries on a database.
+type QueryExecutor interface {
+	// Dialect gets the SQL dialect of the database.
+	Dialect() base.Dialect
+	// Query runs a SQL query and returns the result rows.
+	Query(ctx context.Context, sql string) (RowIterator, error)
+}
+
+// RowIterator iterates over the result rows of a query.
+type RowIterator interface {
+	// Next reads the next row into row. It returns iterator.Done when there are
+	// no more rows.
+	Next(row *[]bigquery.Value) error
+	// Schema gets the column types of the rows. It is only valid after Next
+	// is called.
+	Schema() bigquery.Schema
+	// Close releases the resources of the iterator when not all the rows are
+	// read.
+	Close() error
+}
+
+// BigQueryExecutor runs queries on BigQuery.
+type BigQueryExecutor struct {
+	Client *bigquery.Client
+}
+
+// NewBigQueryExecutor creates a new BigQueryExecutor.
+func NewBigQueryExecutor(client *bigquery.Client) *BigQueryExecutor {
+	return &BigQueryExecutor{Client: client}
+}
+
+// Dialect implements QueryExecutor.Dialect.
+func (e *BigQueryExecutor) Dialect() base.Dialect {
+	return base.BigQuery
+}
+
+// Query implements QueryExecutor.Query.
+func (e *BigQueryExecutor) Query(ctx context.Context, sql string) (RowIterator, error) {
+	it, err := e.Client.Query(sql).Read(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &bigQueryRowIterator{it}, nil
+}
+
+type bigQueryRowIterator struct {
+	it *bigquery.RowIterator
+}
+
+func (b *bigQueryRowIterator) Next(row *[]bigquery.Value) error {
+	return b.it.Next(row)
+}
+
+func (b *bigQueryRowIterator) Schema() bigquery.Schema {
+	return b.it.Schema
+}
+
+func (b *bigQueryRowIterator) Close() error {
+	return nil
+}
+
+// SQLExecutor runs queries on a database/sql database, like PostgreSQL or
+// SQLite. The database driver needs to be linked into the binary.
+type SQLExecutor struct {
+	db      *sql.DB
+	dialect base.Dialect
+}
+
+// NewSQLExecutor creates a new SQLExecutor.
+func NewSQLExecutor(db *sql.DB, dialect base.Dialect) *SQLExecutor {
+	return &SQLExecutor{db: db, dialect: dialect}
+}
+
+// Dialect implements QueryExecutor.Dialect.
+func (e *SQLExecutor) Dialect() base.Dialect {
+	return e.dialect
+}
+
+// Query implements QueryExecutor.Query. The rows are closed when all of them
+// are read or on error.
+func (e *SQLExecutor) Query(ctx context.Context, query string) (RowIterator, error) {
+	rows, err := e.db.QueryContext(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	columnTypes, err := rows.ColumnTypes()
+	if err != nil {
+		rows.Close()
+		return nil, err
+	}
+	schema := bigquery.Schema{}
+	for _, c := range columnTypes {
+		schema = append(schema, &bigquery.FieldSchema{
+			Name: c.Name(),
+			Type: toFieldType(c.DatabaseTypeName()),
+		})
+	}
+	return &sqlRowIterator{rows: rows, schema: schema}, nil
+}
+
+// toFieldType gets the BigQuery field type of a database column type.
+func toFieldType(dbType string) bigquery.FieldType {
+	t := strings.ToUpper(dbType)
+	switch {
+	case strings.Contains(t, "INT"):
+		return bigquery.IntegerFieldType
+	case strings.Contains(t, "REAL"), strings.Contains(t, "FLOA"),
+		strings.Contains(t, "DOUBLE"), strings.Contains(t, "NUMERIC"),
+		strings.Contains(t, "DECIMAL"):
+		return bigquery.FloatFieldType
+	case strings.HasPrefix(t, "BOOL"):
+		return bigquery.BooleanFieldType
+	case strings.HasPrefix(t, "TIMESTAMP"), t == "DATETIME":
+		return bigquery.TimestampFieldType
+	case t == "DATE":
+		return bigquery.DateFieldType
+	case t == "BLOB", t == "BYTEA":
+		return bigquery.BytesFieldType
+	}
+	return bigquery.StringFieldType
+}
+
+type sqlRowIterator struct {
+	rows   *sql.Rows
+	schema bigquery.Schema
+}
+
+func (s *sqlRowIterator) Next(row *[]bigquery.Value) error {
+	if !s.rows.Next() {
+		err := s.rows.Err()
+		s.rows.Close()
+		if err != nil {
+			return err
+		}
+		return iterator.Done
+	}
+	values := make([]interface{}, len(s.schema))
+	ptrs := make([]interface{}, len(s.schema))
+	for i := range values {
+		ptrs[i] = &values[i]
+	}
+	if err := s.rows.Scan(ptrs...); err != nil {
+		s.rows.Close()
+		return err
+	}
+	result := make([]bigquery.Value, len(values))
+	for i, v := range values {
+		result[i] = toValue(v, s.schema[i].Type)
+	}
+	*row = result
+	return nil
+}
+
+func (s *sqlRowIterator) Schema() bigquery.Schema {
+	return s.schema
+}
+
+func (s *sqlRowIterator) Close() error {
+	return s.rows.Close()
+}
+
+// toValue converts a database/sql value to the Go type of a BigQuery value.
+func toValue(v interface{}, t bigquery.FieldType) bigquery.Value {
+	switch x := v.(type) {
+	case []byte:
+		if t == bigquery.BytesFieldType {
+			return x
+		}
+		return string(x)
+	case int64:
+		// SQLite stores booleans as integers.
+		if t == bigquery.BooleanFieldType {
+			return x != 0
+		}
+		return x
+	case int:
+		return int64(x)
+	case int32:
+		return int64(x)
+	case float32:
+		return float64(x)
+	case time.Time:
+		if t == bigquery.DateFieldType {
+			return civil.DateOf(x)
+		}
+		return x
+	}
+	return v
+}
@@ -0,0 +1,87 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package store
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"cloud.google.com/go/bigquery"
+	"cloud.google.com/go/civil"
+	"github.com/datacommonsorg/mixer/internal/base"
+	"github.com/go-test/deep"
+	"google.golang.org/api/iterator"
+	_ "modernc.org/sqlite"
+)
+
+func TestSQLExecutor(t *testing.T) {
+	db, err := sql.Open("sqlite", ":memory:")
+	if err != nil {
+		t.Fatalf("sql.Open() = %s", err)
+	}
+	defer db.Close()
+	// An in-memory database only lives as long as its connection.
+	db.SetMaxOpenConns(1)
+	for _, stmt := range []string{
+		`CREATE TABLE Place (
+			name TEXT, population INTEGER, area REAL, public BOOLEAN, founded DATE, kml BLOB)`,
+		`INSERT INTO Place VALUES ('Mountain View', 82376, 31.8, TRUE, '1902-11-07', X'3C6B6D6C2F3E')`,
+		`INSERT INTO Place VALUES (NULL, NULL, NULL, NULL, NULL, NULL)`,
+	} {
+		if _, err := db.Exec(stmt); err != nil {
+			t.Fatalf("Exec(%s) = %s", stmt, err)
+		}
+	}
+	e := NewSQLExecutor(db, base.SQLite)
+	if e.Dialect() != base.SQLite {
+		t.Errorf("Dialect() = %s, want sqlite", e.Dialect().Name())
+	}
+	it, err := e.Query(context.Background(), "SELECT * FROM Place ORDER BY name DESC")
+	if err != nil {
+		t.Fatalf("Query() = %s", err)
+	}
+	got := [][]bigquery.Value{}
+	for {
+		var row []bigquery.Value
+		err := it.Next(&row)
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			t.Fatalf("Next() = %s", err)
+		}
+		got = append(got, row)
+	}
+	want := [][]bigquery.Value{
+		{"Mountain View", int64(82376), 31.8, true,
+			civil.Date{Year: 1902, Month: 11, Day: 7}, []byte("<kml/>")},
+		{nil, nil, nil, nil, nil, nil},
+	}
+	if diff := deep.Equal(want, got); diff != nil {
+		t.Errorf("Next() unexpected diff %v", diff)
+	}
+	wantSchema := bigquery.Schema{
+		{Name: "name", Type: bigquery.StringFieldType},
+		{Name: "population", Type: bigquery.IntegerFieldType},
+		{Name: "area", Type: bigquery.FloatFieldType},
+		{Name: "public", Type: bigquery.BooleanFieldType},
+		{Name: "founded", Type: bigquery.DateFieldType},
+		{Name: "kml", Type: bigquery.BytesFieldType},
+	}
+	if diff := deep.Equal(wantSchema, it.Schema()); diff != nil {
+		t.Errorf("Schema() unexpected diff %v", diff)
+	}
+}
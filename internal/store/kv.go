@@ -0,0 +1,169 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package store
+
+import (
+	"context"
+	"encoding/csv"
+	"io"
+	"os"
+	"sort"
+	"strings"
+
+	"cloud.google.com/go/bigtable"
+	"github.com/datacommonsorg/mixer/internal/util"
+)
+
+// KVReader reads the rows of a cache table. The values are stored as in
+// Bigtable, which is base64 encoded gzipped data.
+type KVReader interface {
+	// Get reads the value of a key. It returns nil value if the key is not found.
+	Get(ctx context.Context, key string) ([]byte, error)
+	// MultiGet reads the rows of the keys and calls fn for each row found, in
+	// key order. Reading stops when fn returns false.
+	MultiGet(ctx context.Context, keys []string, fn func(key string, value []byte) bool) error
+	// PrefixScan reads the rows whose keys start with prefix and calls fn for
+	// each row, in key order. Reading stops when fn returns false.
+	PrefixScan(ctx context.Context, prefix string, fn func(key string, value []byte) bool) error
+}
+
+// BigtableReader reads cache rows from a Bigtable table.
+type BigtableReader struct {
+	Table *bigtable.Table
+}
+
+// NewBigtableReader creates a new BigtableReader. It returns nil for nil table.
+func NewBigtableReader(table *bigtable.Table) KVReader {
+	if table == nil {
+		return nil
+	}
+	return &BigtableReader{Table: table}
+}
+
+// Get implements KVReader.Get.
+func (r *BigtableReader) Get(ctx context.Context, key string) ([]byte, error) {
+	row, err := r.Table.ReadRow(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+	if len(row[util.BtFamily]) == 0 {
+		return nil, nil
+	}
+	return row[util.BtFamily][0].Value, nil
+}
+
+// MultiGet implements KVReader.MultiGet.
+func (r *BigtableReader) MultiGet(
+	ctx context.Context, keys []string, fn func(key string, value []byte) bool) error {
+	return r.readRows(ctx, bigtable.RowList(keys), fn)
+}
+
+// PrefixScan implements KVReader.PrefixScan.
+func (r *BigtableReader) PrefixScan(
+	ctx context.Context, prefix string, fn func(key string, value []byte) bool) error {
+	return r.readRows(ctx, bigtable.PrefixRange(prefix), fn)
+}
+
+func (r *BigtableReader) readRows(
+	ctx context.Context, rowSet bigtable.RowSet, fn func(key string, value []byte) bool) error {
+	return r.Table.ReadRows(ctx, rowSet, func(btRow bigtable.Row) bool {
+		if len(btRow[util.BtFamily]) == 0 {
+			return true
+		}
+		return fn(btRow.Key(), btRow[util.BtFamily][0].Value)
+	})
+}
+
+// MemoryReader reads cache rows held in memory. It is used to serve a cache
+// exported to a file, without a Bigtable instance.
+type MemoryReader struct {
+	keys   []string
+	values map[string][]byte
+}
+
+// NewMemoryReader creates a new MemoryReader from a map of key to value.
+func NewMemoryReader(data map[string]string) *MemoryReader {
+	r := &MemoryReader{values: map[string][]byte{}}
+	for k, v := range data {
+		r.keys = append(r.keys, k)
+		r.values[k] = []byte(v)
+	}
+	sort.Strings(r.keys)
+	return r
+}
+
+// NewFileReader creates a new MemoryReader from a CSV file with key and value
+// columns, like the output of "cbt read" converted to CSV.
+func NewFileReader(path string) (*MemoryReader, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	reader := csv.NewReader(f)
+	reader.FieldsPerRecord = 2
+	data := map[string]string{}
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		data[record[0]] = record[1]
+	}
+	return NewMemoryReader(data), nil
+}
+
+// Get implements KVReader.Get.
+func (r *MemoryReader) Get(ctx context.Context, key string) ([]byte, error) {
+	return r.values[key], nil
+}
+
+// MultiGet implements KVReader.MultiGet.
+func (r *MemoryReader) MultiGet(
+	ctx context.Context, keys []string, fn func(key string, value []byte) bool) error {
+	sorted := append([]string{}, keys...)
+	sort.Strings(sorted)
+	for i, key := range sorted {
+		if i > 0 && key == sorted[i-1] {
+			continue
+		}
+		value, ok := r.values[key]
+		if !ok {
+			continue
+		}
+		if !fn(key, value) {
+			return nil
+		}
+	}
+	return nil
+}
+
+// PrefixScan implements KVReader.PrefixScan.
+func (r *MemoryReader) PrefixScan(
+	ctx context.Context, prefix string, fn func(key string, value []byte) bool) error {
+	for i := sort.SearchStrings(r.keys, prefix); i < len(r.keys); i++ {
+		key := r.keys[i]
+		if !strings.HasPrefix(key, prefix) {
+			break
+		}
+		if !fn(key, r.values[key]) {
+			return nil
+		}
+	}
+	return nil
+}
@@ -0,0 +1,89 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package store
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/go-test/deep"
+)
+
+func TestMemoryReader(t *testing.T) {
+	ctx := context.Background()
+	dir, err := ioutil.TempDir("", "kv")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	file := filepath.Join(dir, "cache.csv")
+	content := "d/a^1,v1\nd/a^2,v2\nd/b^1,v3\n\"e/c,d\",\"v4,v5\"\n"
+	if err := ioutil.WriteFile(file, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+	r, err := NewFileReader(file)
+	if err != nil {
+		t.Fatalf("NewFileReader() = %s", err)
+	}
+
+	for key, want := range map[string][]byte{
+		"d/a^1": []byte("v1"),
+		"e/c,d": []byte("v4,v5"),
+		"d/a":   nil,
+	} {
+		got, err := r.Get(ctx, key)
+		if err != nil {
+			t.Errorf("Get(%s) = %s", key, err)
+			continue
+		}
+		if diff := deep.Equal(want, got); diff != nil {
+			t.Errorf("Get(%s) unexpected diff %v", key, diff)
+		}
+	}
+
+	for _, c := range []struct {
+		keys   []string
+		prefix string
+		limit  int
+		want   []string
+	}{
+		{[]string{"d/b^1", "x", "d/a^1", "d/b^1"}, "", 0, []string{"d/a^1", "d/b^1"}},
+		{[]string{"d/b^1", "d/a^1", "d/a^2"}, "", 2, []string{"d/a^1", "d/a^2"}},
+		{nil, "d/a", 0, []string{"d/a^1", "d/a^2"}},
+		{nil, "d/", 1, []string{"d/a^1"}},
+		{nil, "f", 0, nil},
+	} {
+		var got []string
+		fn := func(key string, value []byte) bool {
+			got = append(got, key)
+			return c.limit == 0 || len(got) < c.limit
+		}
+		if c.keys != nil {
+			err = r.MultiGet(ctx, c.keys, fn)
+		} else {
+			err = r.PrefixScan(ctx, c.prefix, fn)
+		}
+		if err != nil {
+			t.Errorf("read(%v, %s) = %s", c.keys, c.prefix, err)
+			continue
+		}
+		if diff := deep.Equal(c.want, got); diff != nil {
+			t.Errorf("read(%v, %s) unexpected diff %v", c.keys, c.prefix, diff)
+		}
+	}
+}
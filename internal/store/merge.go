@@ -0,0 +1,102 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package store
+
+import (
+	"strings"
+
+	"github.com/datacommonsorg/mixer/internal/util"
+)
+
+// MergeFunc merges the decoded value of a row in the base cache with the value
+// of the same row in the branch cache. A value is nil when the row is not in
+// that cache.
+type MergeFunc func(base, branch interface{}) interface{}
+
+// BranchOverridesBase uses the branch value when the row is in the branch
+// cache, and the base value otherwise.
+func BranchOverridesBase(base, branch interface{}) interface{} {
+	if branch != nil {
+		return branch
+	}
+	return base
+}
+
+// UnionLists merges []string values, with the base items first followed by
+// the branch items not in base. Values of other types are merged with
+// BranchOverridesBase.
+func UnionLists(base, branch interface{}) interface{} {
+	baseList, ok1 := base.([]string)
+	branchList, ok2 := branch.([]string)
+	if !ok1 || !ok2 {
+		return BranchOverridesBase(base, branch)
+	}
+	return util.MergeDedupe(append([]string{}, baseList...), branchList)
+}
+
+// MergePolicy chooses the MergeFunc of a row by the longest registered prefix
+// of the row key. Rows with no registered prefix use BranchOverridesBase.
+type MergePolicy struct {
+	funcs map[string]MergeFunc
+}
+
+// NewMergePolicy creates a new MergePolicy with no registered prefix.
+func NewMergePolicy() *MergePolicy {
+	return &MergePolicy{funcs: map[string]MergeFunc{}}
+}
+
+// Register sets the MergeFunc of rows with a key prefix.
+func (p *MergePolicy) Register(prefix string, fn MergeFunc) *MergePolicy {
+	p.funcs[prefix] = fn
+	return p
+}
+
+// Get gets the MergeFunc of a row key.
+func (p *MergePolicy) Get(key string) MergeFunc {
+	var result MergeFunc = BranchOverridesBase
+	matched := -1
+	for prefix, fn := range p.funcs {
+		if len(prefix) > matched && strings.HasPrefix(key, prefix) {
+			result = fn
+			matched = len(prefix)
+		}
+	}
+	return result
+}
+
+// MergeRows merges the rows read from base and branch cache. The data maps are
+// keyed by the token of the row key, got from getToken. The result has the
+// tokens of rows in either cache.
+func (p *MergePolicy) MergeRows(
+	keys []string,
+	getToken func(string) (string, error),
+	baseData map[string]interface{},
+	branchData map[string]interface{},
+) map[string]interface{} {
+	result := map[string]interface{}{}
+	for _, key := range keys {
+		token, err := getToken(key)
+		if err != nil {
+			continue
+		}
+		base, inBase := baseData[token]
+		branch, inBranch := branchData[token]
+		if !inBase && !inBranch {
+			continue
+		}
+		result[token] = p.Get(key)(base, branch)
+	}
+	return result
+}
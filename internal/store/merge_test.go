@@ -0,0 +1,62 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package store
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/go-test/deep"
+)
+
+func TestMergePolicy(t *testing.T) {
+	p := NewMergePolicy().
+		Register("d/0/", UnionLists).
+		Register("d/o", func(base, branch interface{}) interface{} { return "o" }).
+		Register("d/o0/", func(base, branch interface{}) interface{} { return "o0" })
+
+	baseData := map[string]interface{}{
+		"a": []string{"x", "y"},
+		"b": []string{"x"},
+		"c": "base",
+		"d": "base",
+		"e": "base",
+	}
+	branchData := map[string]interface{}{
+		"a": []string{"y", "z"},
+		"c": "branch",
+		"f": "branch",
+	}
+	keys := []string{"d/0/a", "d/0/b", "d/7/c", "d/o/d", "d/o0/e", "d/7/f", "d/7/g"}
+	got := p.MergeRows(keys, func(key string) (string, error) {
+		parts := strings.Split(key, "/")
+		return parts[len(parts)-1], nil
+	}, baseData, branchData)
+	want := map[string]interface{}{
+		"a": []string{"x", "y", "z"},
+		"b": []string{"x"},
+		"c": "branch",
+		"d": "o",
+		"e": "o0",
+		"f": "branch",
+	}
+	if diff := deep.Equal(want, got); diff != nil {
+		t.Errorf("MergeRows() unexpected diff %v", diff)
+	}
+	// Base list is not modified.
+	if diff := deep.Equal([]string{"x", "y"}, baseData["a"]); diff != nil {
+		t.Errorf("MergeRows() modified base data %v", diff)
+	}
+}
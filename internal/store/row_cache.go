@@ -0,0 +1,143 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package store
+
+import (
+	"container/list"
+	"sync"
+)
+
+// rowCacheEntryOverhead is the approximate memory used by a cache entry besides
+// its key and value.
+const rowCacheEntryOverhead = 100
+
+type rowCacheKey struct {
+	version string
+	key     string
+}
+
+type rowCacheEntry struct {
+	key   rowCacheKey
+	value []byte
+}
+
+// RowCache is a size-bounded LRU cache of decoded cache table rows, keyed by
+// table version and row key. A nil RowCache caches nothing.
+type RowCache struct {
+	maxBytes int64
+	mu       sync.Mutex
+	bytes    int64
+	ll       *list.List
+	items    map[rowCacheKey]*list.Element
+	hits     uint64
+	misses   uint64
+}
+
+// RowCacheStats holds the counters of a RowCache.
+type RowCacheStats struct {
+	Hits    uint64
+	Misses  uint64
+	Entries int
+	Bytes   int64
+}
+
+// NewRowCache creates a new RowCache that holds up to maxBytes of rows.
+func NewRowCache(maxBytes int64) *RowCache {
+	return &RowCache{
+		maxBytes: maxBytes,
+		ll:       list.New(),
+		items:    map[rowCacheKey]*list.Element{},
+	}
+}
+
+func entrySize(k rowCacheKey, value []byte) int64 {
+	return int64(len(k.version) + len(k.key) + len(value) + rowCacheEntryOverhead)
+}
+
+// Get gets the decoded row of a key in a table version.
+func (c *RowCache) Get(version, key string) ([]byte, bool) {
+	if c == nil {
+		return nil, false
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if e, ok := c.items[rowCacheKey{version, key}]; ok {
+		c.ll.MoveToFront(e)
+		c.hits++
+		return e.Value.(*rowCacheEntry).value, true
+	}
+	c.misses++
+	return nil, false
+}
+
+// Add adds the decoded row of a key in a table version, and evicts the least
+// recently used rows to stay within the size limit. The value must not be
+// modified after it is added.
+func (c *RowCache) Add(version, key string, value []byte) {
+	if c == nil {
+		return
+	}
+	k := rowCacheKey{version, key}
+	size := entrySize(k, value)
+	if size > c.maxBytes {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if e, ok := c.items[k]; ok {
+		c.removeElement(e)
+	}
+	c.items[k] = c.ll.PushFront(&rowCacheEntry{key: k, value: value})
+	c.bytes += size
+	for c.bytes > c.maxBytes {
+		c.removeElement(c.ll.Back())
+	}
+}
+
+// RemoveVersion removes all the rows of a table version.
+func (c *RowCache) RemoveVersion(version string) {
+	if c == nil {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for k, e := range c.items {
+		if k.version == version {
+			c.removeElement(e)
+		}
+	}
+}
+
+func (c *RowCache) removeElement(e *list.Element) {
+	entry := e.Value.(*rowCacheEntry)
+	c.ll.Remove(e)
+	delete(c.items, entry.key)
+	c.bytes -= entrySize(entry.key, entry.value)
+}
+
+// Stats gets the counters of the cache.
+func (c *RowCache) Stats() RowCacheStats {
+	if c == nil {
+		return RowCacheStats{}
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return RowCacheStats{
+		Hits:    c.hits,
+		Misses:  c.misses,
+		Entries: c.ll.Len(),
+		Bytes:   c.bytes,
+	}
+}
@@ -0,0 +1,86 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package store
+
+import (
+	"testing"
+
+	"github.com/go-test/deep"
+)
+
+func TestRowCache(t *testing.T) {
+	// Room for two entries of one byte keys and values.
+	c := NewRowCache(2 * (4 + 1 + 1 + rowCacheEntryOverhead))
+	c.Add("base", "a", []byte("1"))
+	c.Add("base", "b", []byte("2"))
+	if _, ok := c.Get("base", "a"); !ok {
+		t.Errorf("Get(base, a) missed")
+	}
+	// b is the least recently used and is evicted.
+	c.Add("base", "c", []byte("3"))
+	if _, ok := c.Get("base", "b"); ok {
+		t.Errorf("Get(base, b) hit after eviction")
+	}
+	if v, ok := c.Get("base", "c"); !ok || string(v) != "3" {
+		t.Errorf("Get(base, c) = %s, %t, want 3, true", v, ok)
+	}
+	// Same key in another version is a different row.
+	if _, ok := c.Get("next", "c"); ok {
+		t.Errorf("Get(next, c) hit")
+	}
+	// Too large for the cache.
+	c.Add("base", "d", make([]byte, 1000))
+	if _, ok := c.Get("base", "d"); ok {
+		t.Errorf("Get(base, d) hit for oversized row")
+	}
+	want := RowCacheStats{Hits: 2, Misses: 3, Entries: 2, Bytes: 2 * (4 + 1 + 1 + rowCacheEntryOverhead)}
+	if diff := deep.Equal(want, c.Stats()); diff != nil {
+		t.Errorf("Stats() unexpected diff %v", diff)
+	}
+
+	c.RemoveVersion("base")
+	if got := c.Stats(); got.Entries != 0 || got.Bytes != 0 {
+		t.Errorf("Stats() = %+v after RemoveVersion, want no entries", got)
+	}
+
+	var nilCache *RowCache
+	nilCache.Add("base", "a", []byte("1"))
+	if _, ok := nilCache.Get("base", "a"); ok {
+		t.Errorf("Get(base, a) hit on nil cache")
+	}
+}
+
+func TestUpdateBranchBtRowCache(t *testing.T) {
+	st := NewStore(nil, nil, nil)
+	c := NewRowCache(1 << 20)
+	st.SetRowCache(c)
+	st.UpdateBranchBt(NewMemoryReader(map[string]string{}))
+	oldVersion := st.BranchLayers()[0].Version
+	c.Add(oldVersion, "a", []byte("1"))
+	_, baseVersion := st.BaseBtVersion()
+	c.Add(baseVersion, "a", []byte("1"))
+
+	st.UpdateBranchBt(NewMemoryReader(map[string]string{}))
+	newVersion := st.BranchLayers()[0].Version
+	if newVersion == oldVersion {
+		t.Fatalf("BranchLayers() version = %s after update, want new version", newVersion)
+	}
+	if _, ok := c.Get(oldVersion, "a"); ok {
+		t.Errorf("Get(%s, a) hit after branch update", oldVersion)
+	}
+	if _, ok := c.Get(baseVersion, "a"); !ok {
+		t.Errorf("Get(%s, a) missed after branch update", baseVersion)
+	}
+}
@@ -23,29 +23,61 @@ import (
 
 // Store holds the handlers to BigQuery and Bigtable
 type Store struct {
-	BqClient    *bigquery.Client
-	baseTable   *bigtable.Table
-	branchTable *bigtable.Table
-	branchLock  sync.RWMutex
+	BqClient  *bigquery.Client
+	baseTable KVReader
+	// branchLayers are the branch cache tables stacked on the base cache, from
+	// the lowest to the highest priority.
+	branchLayers []*BranchLayer
+	branchLock   sync.RWMutex
+	// Executor runs the translated SQL queries, on BigQuery by default.
+	Executor QueryExecutor
+	rowCache *RowCache
+	// mergePolicy merges the rows of base and branch cache.
+	mergePolicy *MergePolicy
+	// branchGen is incremented when a branch table is set, for the version of
+	// the table in the row cache.
+	branchGen int
 }
 
-// BaseBt is the accessor for base bigtable
-func (st *Store) BaseBt() *bigtable.Table {
+// baseVersion is the table version of the base cache table in the row cache.
+const baseVersion = "base"
+
+// BaseBt is the accessor for base cache table
+func (st *Store) BaseBt() KVReader {
 	return st.baseTable
 }
 
-// BranchBt is the accessor for branch bigtable
-func (st *Store) BranchBt() *bigtable.Table {
-	st.branchLock.RLock()
-	defer st.branchLock.RUnlock()
-	return st.branchTable
+// SetBaseBt sets the base cache table. It is not safe to call while serving.
+func (st *Store) SetBaseBt(baseTable KVReader) {
+	st.baseTable = baseTable
+	st.rowCache.RemoveVersion(baseVersion)
+}
+
+// BaseBtVersion gets the base cache table with its version in the row cache.
+func (st *Store) BaseBtVersion() (KVReader, string) {
+	return st.baseTable, baseVersion
+}
+
+// RowCache gets the cache of decoded rows, which is nil if not enabled.
+func (st *Store) RowCache() *RowCache {
+	return st.rowCache
 }
 
-// UpdateBranchBt updates the branch bigtable
-func (st *Store) UpdateBranchBt(branchTable *bigtable.Table) {
-	st.branchLock.Lock()
-	defer st.branchLock.Unlock()
-	st.branchTable = branchTable
+// SetRowCache sets the cache of decoded rows. It is not safe to call while
+// serving.
+func (st *Store) SetRowCache(rowCache *RowCache) {
+	st.rowCache = rowCache
+}
+
+// MergePolicy gets the policy to merge the rows of base and branch cache.
+func (st *Store) MergePolicy() *MergePolicy {
+	return st.mergePolicy
+}
+
+// SetMergePolicy sets the policy to merge the rows of base and branch cache.
+// It is not safe to call while serving.
+func (st *Store) SetMergePolicy(mergePolicy *MergePolicy) {
+	st.mergePolicy = mergePolicy
 }
 
 // NewStore creates a new store.
@@ -53,9 +85,14 @@ func NewStore(
 	bqClient *bigquery.Client,
 	baseTable *bigtable.Table,
 	branchTable *bigtable.Table) *Store {
-	return &Store{
+	st := &Store{
 		BqClient:    bqClient,
-		baseTable:   baseTable,
-		branchTable: branchTable,
+		baseTable:   NewBigtableReader(baseTable),
+		mergePolicy: NewMergePolicy(),
+	}
+	st.UpdateBranchBt(NewBigtableReader(branchTable))
+	if bqClient != nil {
+		st.Executor = NewBigQueryExecutor(bqClient)
 	}
+	return st
 }
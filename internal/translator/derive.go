@@ -15,6 +15,8 @@
 package translator
 
 import (
+	// Embed the built-in table types.
+	_ "embed"
 	"encoding/json"
 	"io/ioutil"
 
@@ -56,15 +58,25 @@ type InArcInfo struct {
 	ObjCol string
 }
 
-// GetSubTypeMap gets subtype map.
+// Table types built into the binary.
+//
+//go:embed table_types.json
+var defaultTableTypesJSON []byte
+
+// GetSubTypeMap gets subtype map from a table types JSON file. The built-in
+// table types are used when the file path is empty.
 func GetSubTypeMap(tableTypesJSONFilePath string) (map[string]string, error) {
-	tableTypesJSON, err := ioutil.ReadFile(tableTypesJSONFilePath)
-	if err != nil {
-		return nil, err
+	tableTypesJSON := defaultTableTypesJSON
+	if tableTypesJSONFilePath != "" {
+		var err error
+		tableTypesJSON, err = ioutil.ReadFile(tableTypesJSONFilePath)
+		if err != nil {
+			return nil, err
+		}
 	}
 	result := map[string]string{}
 	tableTypes := tableTypes{}
-	err = json.Unmarshal(tableTypesJSON, &tableTypes)
+	err := json.Unmarshal(tableTypesJSON, &tableTypes)
 	if err != nil {
 		return nil, err
 	}
@@ -294,8 +306,14 @@ func RewriteQuery(queries []*base.Query, subTypeMap map[string]string) []*base.Q
 			continue
 		}
 		in := typeOfNodeInfo[n]
-		res[in.pos] = base.NewQuery(base.TypeOf, n.Alias, subTypeMap[in.t])
-		res = append(res, base.NewQuery("subType", n.Alias, in.t))
+		// Copy the statement to keep the OPTIONAL and UNION information.
+		typeOfQuery := *res[in.pos]
+		typeOfQuery.Obj = subTypeMap[in.t]
+		subTypeQuery := typeOfQuery
+		subTypeQuery.Pred = "subType"
+		subTypeQuery.Obj = in.t
+		res[in.pos] = &typeOfQuery
+		res = append(res, &subTypeQuery)
 	}
 	return res
 }
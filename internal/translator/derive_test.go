@@ -339,6 +339,23 @@ func TestGetProvColumn(t *testing.T) {
 	}
 }
 
+func TestGetSubTypeMap(t *testing.T) {
+	want, err := GetSubTypeMap("table_types.json")
+	if err != nil {
+		t.Fatalf("GetSubTypeMap(table_types.json) = %v", err)
+	}
+	got, err := GetSubTypeMap("")
+	if err != nil {
+		t.Fatalf("GetSubTypeMap() = %v", err)
+	}
+	if diff := deep.Equal(want, got); diff != nil {
+		t.Errorf("GetSubTypeMap() unexpected diff with built-in table types %v", diff)
+	}
+	if got["City"] != "Place" {
+		t.Errorf("GetSubTypeMap()[City] = %s, want Place", got["City"])
+	}
+}
+
 func TestRewriteQuery(t *testing.T) {
 	subTypeMap, err := GetSubTypeMap("table_types.json")
 	if err != nil {
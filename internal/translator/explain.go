@@ -0,0 +1,241 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package translator
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/datacommonsorg/mixer/internal/base"
+	"google.golang.org/grpc/status"
+)
+
+// Plan explains how a query is translated to SQL.
+type Plan struct {
+	// Plans of the UNION branches, or a single plan without UNION.
+	Branches []*BranchPlan `json:"branches"`
+	// The final SQL, if the translation succeeds.
+	SQL string `json:"sql,omitempty"`
+	// Error of the translation.
+	Error string `json:"error,omitempty"`
+}
+
+// BranchPlan explains the translation of query statements without UNION.
+type BranchPlan struct {
+	// Query statements after the sub type rewrite.
+	Queries []string `json:"queries"`
+	// Mappings removed by PruneMapping as foreign key entities.
+	PrunedMappings []string `json:"prunedMappings"`
+	// Functional dependencies that identify each entity.
+	FuncDeps []*FuncDepPlan `json:"funcDeps"`
+	// Candidate mappings of each query statement.
+	Bindings []*BindingPlan `json:"bindings"`
+	// Number of binding sets. The first binding set is used for translation.
+	BindingSets int `json:"bindingSets"`
+	// Edges of the matching graph of the first binding set.
+	Graph []*EdgePlan `json:"graph"`
+	// SQL constraints obtained from the matching graph.
+	Constraints []string `json:"constraints"`
+	// Error of the translation.
+	Error string `json:"error,omitempty"`
+}
+
+// FuncDepPlan is a functional dependency of an entity.
+type FuncDepPlan struct {
+	Entity   string `json:"entity"`
+	Property string `json:"property"`
+	Column   string `json:"column"`
+}
+
+// BindingPlan holds the candidate mappings of a query statement.
+type BindingPlan struct {
+	Query string `json:"query"`
+	// Statements with the same query ID share a table instance in SQL.
+	QueryID int `json:"queryId"`
+	// Whether the statement is matched against the Triples table.
+	Triple   bool     `json:"triple"`
+	Mappings []string `json:"mappings"`
+}
+
+// EdgePlan is an edge of the matching graph between query and mapping terms.
+type EdgePlan struct {
+	From string `json:"from"`
+	To   string `json:"to"`
+}
+
+func queryString(q *base.Query) string {
+	return fmt.Sprintf("%s %s %v", q.Sub, q.Pred, q.Obj)
+}
+
+func mappingString(m *base.Mapping) string {
+	if _, ok := m.Pred.(base.FuncDeps); ok {
+		return fmt.Sprintf("%s functionalDeps %v", m.Sub, m.Obj)
+	}
+	return fmt.Sprintf("%s %v %v", m.Sub, m.Pred, m.Obj)
+}
+
+func termString(t interface{}) string {
+	if strSlice, ok := t.(*[]string); ok {
+		return fmt.Sprintf("%v", *strSlice)
+	}
+	return fmt.Sprintf("%v", t)
+}
+
+// Explain explains the translation of a datalog query. Failures are reported
+// in the plan, which holds the steps up to the failure.
+func Explain(
+	mappings []*base.Mapping, nodes []base.Node, queries []*base.Query,
+	subTypeMap map[string]string, options ...*base.QueryOptions) *Plan {
+	var queryOptions *base.QueryOptions
+	if len(options) > 0 {
+		queryOptions = options[0]
+	} else {
+		queryOptions = &base.QueryOptions{}
+	}
+	plan := &Plan{}
+	translation, err := translateWithTrace(
+		mappings, nodes, queries, subTypeMap, queryOptions, plan)
+	if err != nil {
+		plan.Error = status.Convert(err).Message()
+		return plan
+	}
+	plan.SQL = translation.SQL
+	return plan
+}
+
+// The following methods record the translation steps. They do nothing on a
+// nil plan, which is the case when the translation is not explained.
+
+// addBranch adds the plan of a branch, or returns nil for a nil plan.
+func (p *Plan) addBranch() *BranchPlan {
+	if p == nil {
+		return nil
+	}
+	b := &BranchPlan{}
+	p.Branches = append(p.Branches, b)
+	return b
+}
+
+func (p *BranchPlan) setError(err error) {
+	if p == nil {
+		return
+	}
+	p.Error = status.Convert(err).Message()
+}
+
+func (p *BranchPlan) recordFuncDeps(funcDeps map[base.Entity]map[string]interface{}) {
+	if p == nil {
+		return
+	}
+	for e, props := range funcDeps {
+		for prop, c := range props {
+			p.FuncDeps = append(p.FuncDeps, &FuncDepPlan{e.String(), prop, fmt.Sprintf("%v", c)})
+		}
+	}
+	sort.Slice(p.FuncDeps, func(i, j int) bool {
+		if p.FuncDeps[i].Entity != p.FuncDeps[j].Entity {
+			return p.FuncDeps[i].Entity < p.FuncDeps[j].Entity
+		}
+		return p.FuncDeps[i].Property < p.FuncDeps[j].Property
+	})
+}
+
+func (p *BranchPlan) recordPrunedMappings(mappings, pruned []*base.Mapping) {
+	if p == nil {
+		return
+	}
+	kept := map[*base.Mapping]struct{}{}
+	for _, m := range pruned {
+		kept[m] = struct{}{}
+	}
+	for _, m := range mappings {
+		if _, ok := kept[m]; !ok {
+			p.PrunedMappings = append(p.PrunedMappings, mappingString(m))
+		}
+	}
+}
+
+func (p *BranchPlan) recordQueries(queries []*base.Query) {
+	if p == nil {
+		return
+	}
+	for _, q := range queries {
+		p.Queries = append(p.Queries, queryString(q))
+	}
+}
+
+func (p *BranchPlan) recordBindings(
+	queries []*base.Query, queryID map[*base.Query]int,
+	matchTriple map[*base.Query]bool, bindingMap map[*base.Query][]*base.Mapping) {
+	if p == nil {
+		return
+	}
+	for _, q := range queries {
+		b := &BindingPlan{
+			Query:    queryString(q),
+			QueryID:  queryID[q],
+			Triple:   matchTriple[q],
+			Mappings: []string{},
+		}
+		for _, m := range bindingMap[q] {
+			b.Mappings = append(b.Mappings, mappingString(m))
+		}
+		p.Bindings = append(p.Bindings, b)
+	}
+}
+
+func (p *BranchPlan) recordBindingSets(n int) {
+	if p == nil {
+		return
+	}
+	p.BindingSets = n
+}
+
+func (p *BranchPlan) recordGraph(graph Graph) {
+	if p == nil {
+		return
+	}
+	seen := map[EdgePlan]struct{}{}
+	for key, values := range graph {
+		for v := range values {
+			edge := EdgePlan{termString(key), termString(v)}
+			if edge.From > edge.To {
+				edge.From, edge.To = edge.To, edge.From
+			}
+			if _, ok := seen[edge]; ok {
+				continue
+			}
+			seen[edge] = struct{}{}
+			p.Graph = append(p.Graph, &EdgePlan{edge.From, edge.To})
+		}
+	}
+	sort.Slice(p.Graph, func(i, j int) bool {
+		if p.Graph[i].From != p.Graph[j].From {
+			return p.Graph[i].From < p.Graph[j].From
+		}
+		return p.Graph[i].To < p.Graph[j].To
+	})
+}
+
+func (p *BranchPlan) recordConstraints(constraints []Constraint) {
+	if p == nil {
+		return
+	}
+	sorted := append([]Constraint{}, constraints...)
+	sortConstraints(sorted)
+	for _, c := range sorted {
+		p.Constraints = append(p.Constraints, fmt.Sprintf("%s = %v", c.LHS, c.RHS))
+	}
+}
@@ -0,0 +1,124 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package translator
+
+import (
+	"testing"
+
+	"github.com/datacommonsorg/mixer/internal/sparql"
+	"github.com/go-test/deep"
+)
+
+func TestExplain(t *testing.T) {
+	mappings, err := ParseMapping(`
+Node: E:Place->E1
+typeOf: Place
+dcid: C:Place->id
+name: C:Place->name
+functionalDeps: dcid
+`, "dc")
+	if err != nil {
+		t.Fatalf("ParseMapping() = %s", err)
+	}
+	for _, c := range []struct {
+		name     string
+		queryStr string
+		want     *BranchPlan
+		wantSQL  string
+		wantErr  bool
+	}{
+		{
+			"bound",
+			`SELECT ?name WHERE { ?p typeOf Place . ?p name ?name }`,
+			&BranchPlan{
+				Queries: []string{"?p typeOf Place", "?p name ?name"},
+				FuncDeps: []*FuncDepPlan{
+					{"`dc.Place`->E1", "dcid", "`dc.Place`->id"},
+				},
+				Bindings: []*BindingPlan{
+					{
+						Query:    "?p typeOf Place",
+						Mappings: []string{"`dc.Place`->E1 typeOf Place"},
+					},
+					{
+						Query:    "?p name ?name",
+						Mappings: []string{"`dc.Place`->E1 name `dc.Place`->name"},
+					},
+				},
+				BindingSets: 1,
+				Graph: []*EdgePlan{
+					{"?name", "`dc.Place`0->name"},
+					{"?p", "`dc.Place`0->E1"},
+				},
+				Constraints: []string{
+					"`dc.Place`0->id = ?p",
+					"`dc.Place`0->name = ?name",
+				},
+			},
+			"SELECT _dc_Place_0.name AS name FROM `dc.Place` AS _dc_Place_0",
+			false,
+		},
+		{
+			"unbound",
+			`SELECT ?name WHERE { ?p typeOf Place . ?p population ?x }`,
+			&BranchPlan{
+				Queries: []string{"?p typeOf Place", "?p population ?x"},
+				FuncDeps: []*FuncDepPlan{
+					{"`dc.Place`->E1", "dcid", "`dc.Place`->id"},
+				},
+				Bindings: []*BindingPlan{
+					{
+						Query:    "?p typeOf Place",
+						Mappings: []string{"`dc.Place`->E1 typeOf Place"},
+					},
+					{
+						Query:    "?p population ?x",
+						Triple:   true,
+						Mappings: []string{},
+					},
+				},
+				Error: "No mapping found for query statements: ?p population ?x",
+			},
+			"",
+			true,
+		},
+	} {
+		nodes, queries, opts, err := sparql.ParseQuery(c.queryStr)
+		if err != nil {
+			t.Errorf("ParseQuery(%s) = %s", c.name, err)
+			continue
+		}
+		plan := Explain(mappings, nodes, queries, map[string]string{}, opts)
+		if diff := deep.Equal([]*BranchPlan{c.want}, plan.Branches); diff != nil {
+			t.Errorf("Explain(%s) unexpected plan diff %v", c.name, diff)
+		}
+		if diff := deep.Equal(c.wantSQL, plan.SQL); diff != nil {
+			t.Errorf("Explain(%s) unexpected sql diff %v", c.name, diff)
+		}
+		if c.wantErr != (plan.Error != "") {
+			t.Errorf("Explain(%s) error = %s, want error %t", c.name, plan.Error, c.wantErr)
+		}
+	}
+	nodes, queries, opts, err := sparql.ParseQuery(
+		`SELECT ?name WHERE { { ?p typeOf Place . ?p name ?name } UNION { ?p typeOf Place . ?p dcid ?name } }`)
+	if err != nil {
+		t.Fatalf("ParseQuery(union) = %s", err)
+	}
+	plan := Explain(mappings, nodes, queries, map[string]string{}, opts)
+	if plan.Error != "" || len(plan.Branches) != 2 {
+		t.Errorf("Explain(union) got %d branches and error %q, want 2 branches",
+			len(plan.Branches), plan.Error)
+	}
+}
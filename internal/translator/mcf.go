@@ -102,8 +102,9 @@ func ParseQuery(queryString string) ([]base.Node, []*base.Query, error) {
 	return nodes, queries, nil
 }
 
-// ParseMapping parses schema mapping mcf into a list of Mapping struct.
-func ParseMapping(mcf, database string) ([]*base.Mapping, error) {
+// ParseMapping parses schema mapping mcf into a list of Mapping struct. Table
+// names are in BigQuery dialect unless another dialect is given.
+func ParseMapping(mcf, database string, dialect ...base.Dialect) ([]*base.Mapping, error) {
 	lines := strings.Split(mcf, "\n")
 	mappings := []*base.Mapping{}
 	var sub string
@@ -126,7 +127,7 @@ func ParseMapping(mcf, database string) ([]*base.Mapping, error) {
 			if sub == "" {
 				return nil, status.Error(codes.InvalidArgument, "Missing Node identifier")
 			}
-			m, err := base.NewMapping(head, sub, body, database)
+			m, err := base.NewMapping(head, sub, body, database, dialect...)
 			if err != nil {
 				return nil, err
 			}
@@ -0,0 +1,166 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package translator
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/datacommonsorg/mixer/internal/base"
+	"github.com/datacommonsorg/mixer/internal/util"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// maxPathBranches is the maximum number of UNION branches that the transitive
+// statements of a query are expanded to.
+const maxPathBranches = 16
+
+// transitivePred is the only predicate a transitive statement can have, as the
+// depth of its chain is known from the place type hierarchy.
+const transitivePred = "containedInPlace"
+
+// pathDepths gets the numbers of repetitions a transitive statement is expanded
+// to. They are the depths between the subject type and the object type, or all
+// the ancestor types when the object type is unknown.
+func pathDepths(
+	q *base.Query,
+	types map[string]string,
+	containedIn map[util.TypePair][]int) ([]int, error) {
+	if q.Pred != transitivePred {
+		return nil, status.Errorf(codes.InvalidArgument,
+			"Property path %s+ is not supported, only %s+ is", q.Pred, transitivePred)
+	}
+	if len(containedIn) == 0 {
+		return nil, status.Errorf(codes.FailedPrecondition,
+			"Place type relations are not loaded for property path %s+", q.Pred)
+	}
+	subType, ok := types[q.Sub.Alias]
+	if !ok {
+		return nil, status.Errorf(codes.InvalidArgument,
+			"Property path %s+ needs the type of %s", q.Pred, q.Sub.Alias)
+	}
+	if obj, ok := q.Obj.(base.Node); ok {
+		if objType, ok := types[obj.Alias]; ok {
+			depths := containedIn[util.TypePair{Child: subType, Parent: objType}]
+			if len(depths) == 0 {
+				return nil, status.Errorf(codes.InvalidArgument,
+					"Type %s is not contained in type %s", subType, objType)
+			}
+			return depths, nil
+		}
+	}
+	seen := map[int]struct{}{}
+	result := []int{}
+	for pair, depths := range containedIn {
+		if pair.Child != subType {
+			continue
+		}
+		for _, d := range depths {
+			if _, ok := seen[d]; !ok {
+				seen[d] = struct{}{}
+				result = append(result, d)
+			}
+		}
+	}
+	if len(result) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument,
+			"Type %s is not contained in any type", subType)
+	}
+	sort.Ints(result)
+	return result, nil
+}
+
+// pathChain converts a transitive statement to a chain of depth statements,
+// connected by intermediate nodes.
+func pathChain(q *base.Query, id, depth int) []*base.Query {
+	result := []*base.Query{}
+	sub := q.Sub
+	for i := 0; i < depth; i++ {
+		query := *q
+		query.Transitive = false
+		query.Sub = sub
+		if i < depth-1 {
+			obj := base.NewNode(fmt.Sprintf("?_hop%d_%d", id, i))
+			query.Obj = obj
+			sub = obj
+		}
+		result = append(result, &query)
+	}
+	return result
+}
+
+// expandTransitive expands the transitive statements of each UNION branch to
+// chains of statements, with a new branch for each combination of depths.
+func expandTransitive(
+	queries []*base.Query,
+	containedIn map[util.TypePair][]int) ([]*base.Query, error) {
+	hasTransitive := false
+	for _, q := range queries {
+		hasTransitive = hasTransitive || q.Transitive
+	}
+	if !hasTransitive {
+		return queries, nil
+	}
+	result := []*base.Query{}
+	branchID := 0
+	for _, branch := range getBranches(queries) {
+		types := map[string]string{}
+		for _, q := range branch {
+			if t, ok := q.Obj.(string); ok && q.IsTypeOf() {
+				types[q.Sub.Alias] = t
+			}
+		}
+		alternatives := [][]*base.Query{{}}
+		for i, q := range branch {
+			if !q.Transitive {
+				for j := range alternatives {
+					alternatives[j] = append(alternatives[j], q)
+				}
+				continue
+			}
+			depths, err := pathDepths(q, types, containedIn)
+			if err != nil {
+				return nil, err
+			}
+			if branchID+len(alternatives)*len(depths) > maxPathBranches {
+				return nil, status.Errorf(codes.InvalidArgument,
+					"Property paths expand to more than %d patterns", maxPathBranches)
+			}
+			expanded := [][]*base.Query{}
+			for _, alternative := range alternatives {
+				for _, depth := range depths {
+					expanded = append(expanded, append(
+						append([]*base.Query{}, alternative...), pathChain(q, i, depth)...))
+				}
+			}
+			alternatives = expanded
+		}
+		for _, alternative := range alternatives {
+			for _, q := range alternative {
+				query := *q
+				query.Branch = branchID
+				result = append(result, &query)
+			}
+			branchID++
+		}
+	}
+	if branchID > maxPathBranches {
+		return nil, status.Errorf(codes.InvalidArgument,
+			"Property paths expand to more than %d patterns", maxPathBranches)
+	}
+	return result, nil
+}
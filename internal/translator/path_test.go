@@ -0,0 +1,151 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package translator
+
+import (
+	"testing"
+
+	"github.com/datacommonsorg/mixer/internal/sparql"
+	"github.com/datacommonsorg/mixer/internal/util"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestTransitivePath(t *testing.T) {
+	subTypeMap, err := GetSubTypeMap("table_types.json")
+	if err != nil {
+		t.Fatalf("GetSubTypeMap() = %v", err)
+	}
+	mappings := readTestMapping(t, []string{"test_mapping.mcf"})
+	containedIn := map[util.TypePair][]int{
+		{Child: "County", Parent: "State"}:   {1},
+		{Child: "County", Parent: "Country"}: {1, 2},
+		{Child: "State", Parent: "Country"}:  {1},
+	}
+	for _, c := range []struct {
+		name     string
+		queryStr string
+		wantSQL  string
+		wantCode codes.Code
+	}{
+		{
+			"typed-object",
+			`
+				SELECT ?name
+				WHERE {
+				 ?place typeOf County .
+				 ?place containedInPlace+ ?state .
+				 ?state typeOf State .
+				 ?state name "California" .
+				 ?place name ?name .
+				}
+				`,
+			"SELECT _dc_v3_Place_0.name AS name " +
+				"FROM `dc_v3.Place` AS _dc_v3_Place_1 " +
+				"JOIN `dc_v3.Triple` AS _dc_v3_Triple_0 ON _dc_v3_Place_1.id = _dc_v3_Triple_0.object_id " +
+				"JOIN `dc_v3.Place` AS _dc_v3_Place_0 ON _dc_v3_Triple_0.subject_id = _dc_v3_Place_0.id " +
+				"WHERE _dc_v3_Place_0.type = \"County\" " +
+				"AND _dc_v3_Place_1.name = \"California\" " +
+				"AND _dc_v3_Place_1.type = \"State\" " +
+				"AND _dc_v3_Triple_0.predicate = \"containedInPlace\"",
+			codes.OK,
+		},
+		{
+			"aggregate",
+			`
+				SELECT ?country (COUNT(?place) AS ?count)
+				WHERE {
+				 ?place typeOf County .
+				 ?place containedInPlace+ ?country .
+				 ?country typeOf Country .
+				}
+				GROUP BY ?country
+				ORDER BY DESC(?count)
+				`,
+			"SELECT country, COUNT(place) AS count FROM (" +
+				"SELECT _dc_v3_Place_1.id AS country, _dc_v3_Place_0.id AS place " +
+				"FROM `dc_v3.Place` AS _dc_v3_Place_0 " +
+				"JOIN `dc_v3.Triple` AS _dc_v3_Triple_0 ON _dc_v3_Place_0.id = _dc_v3_Triple_0.subject_id " +
+				"JOIN `dc_v3.Place` AS _dc_v3_Place_1 ON _dc_v3_Triple_0.object_id = _dc_v3_Place_1.id " +
+				"WHERE _dc_v3_Place_0.type = \"County\" " +
+				"AND _dc_v3_Place_1.type = \"Country\" " +
+				"AND _dc_v3_Triple_0.predicate = \"containedInPlace\" " +
+				"UNION ALL " +
+				"SELECT _dc_v3_Place_1.id AS country, _dc_v3_Place_0.id AS place " +
+				"FROM `dc_v3.Place` AS _dc_v3_Place_0 " +
+				"JOIN `dc_v3.Triple` AS _dc_v3_Triple_0 ON _dc_v3_Place_0.id = _dc_v3_Triple_0.subject_id " +
+				"JOIN `dc_v3.Triple` AS _dc_v3_Triple_1 ON _dc_v3_Triple_0.object_id = _dc_v3_Triple_1.subject_id " +
+				"JOIN `dc_v3.Place` AS _dc_v3_Place_1 ON _dc_v3_Triple_1.object_id = _dc_v3_Place_1.id " +
+				"WHERE _dc_v3_Place_0.type = \"County\" " +
+				"AND _dc_v3_Place_1.type = \"Country\" " +
+				"AND _dc_v3_Triple_0.predicate = \"containedInPlace\" " +
+				"AND _dc_v3_Triple_1.predicate = \"containedInPlace\"" +
+				") AS _union GROUP BY country ORDER BY count DESC",
+			codes.OK,
+		},
+		{
+			"untyped-subject",
+			`SELECT ?place WHERE { ?place containedInPlace+ country/USA . }`,
+			"",
+			codes.InvalidArgument,
+		},
+		{
+			"no-containment",
+			`SELECT ?place WHERE { ?place typeOf Country . ?place containedInPlace+ country/USA . }`,
+			"",
+			codes.InvalidArgument,
+		},
+		{
+			"too-many-branches",
+			`
+				SELECT ?a
+				WHERE {
+				 ?a typeOf County .
+				 ?a containedInPlace+ ?b .
+				 ?a containedInPlace+ ?c .
+				 ?a containedInPlace+ ?d .
+				 ?a containedInPlace+ ?e .
+				 ?a containedInPlace+ ?f .
+				}
+				`,
+			"",
+			codes.InvalidArgument,
+		},
+		{
+			"other-predicate",
+			`SELECT ?place WHERE { ?place typeOf County . ?place name+ ?name . }`,
+			"",
+			codes.InvalidArgument,
+		},
+	} {
+		nodes, queries, opts, err := sparql.ParseQuery(c.queryStr)
+		if err != nil {
+			t.Errorf("ParseQuery(%s) = %s", c.name, err)
+			continue
+		}
+		opts.ContainedIn = containedIn
+		translation, err := Translate(mappings, nodes, queries, subTypeMap, opts)
+		if got := status.Code(err); got != c.wantCode {
+			t.Errorf("Translate(%s) = %v, want code %s", c.name, err, c.wantCode)
+			continue
+		}
+		if err != nil {
+			continue
+		}
+		if translation.SQL != c.wantSQL {
+			t.Errorf("Translate(%s) got SQL %s, want %s", c.name, translation.SQL, c.wantSQL)
+		}
+	}
+}
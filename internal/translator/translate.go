@@ -16,6 +16,7 @@ package translator
 
 import (
 	"fmt"
+	"math"
 	"sort"
 	"strconv"
 	"strings"
@@ -60,6 +61,9 @@ type Translation struct {
 type ProvInfo struct {
 	query     bool
 	tableProv map[string]base.Column
+	// perNode selects a provenance column for each node, which is NULL for nodes
+	// without provenance, so that the columns of UNION branches are aligned.
+	perNode bool
 }
 
 // Graph represents the struct for terms matching.
@@ -67,20 +71,30 @@ type Graph map[interface{}]map[interface{}]struct{}
 
 type tableConstraint map[base.Table][]Constraint
 
-func addQuote(s string, useQuote ...bool) string {
+// addQuote gets the SQL literal of a constant. Numbers are not quoted unless
+// useQuote is set. The constant can be wrapped in double quotes already.
+func addQuote(d base.Dialect, s string, useQuote ...bool) string {
 	if len(useQuote) == 0 || !useQuote[0] {
 		if _, err := strconv.ParseFloat(s, 64); err == nil {
 			return s
 		}
 	}
-
-	if !strings.HasPrefix(s, `"`) {
-		s = `"` + s
+	if len(s) >= 2 && strings.HasPrefix(s, `"`) && strings.HasSuffix(s, `"`) {
+		if v, err := strconv.Unquote(s); err == nil {
+			s = v
+		} else {
+			s = s[1 : len(s)-1]
+		}
 	}
-	if !strings.HasSuffix(s, `"`) {
-		s += `"`
+	return d.String(s)
+}
+
+// getDialect gets the SQL dialect of the query options.
+func getDialect(opts *base.QueryOptions) base.Dialect {
+	if opts == nil || opts.Dialect == nil {
+		return base.BigQuery
 	}
-	return s
+	return opts.Dialect
 }
 
 func sortMapSet(m map[interface{}]struct{}) []interface{} {
@@ -630,17 +644,255 @@ func (jc tableConstraint) remove(
 	jc[t] = cs
 }
 
+// getOrderLimitSQL gets the ORDER BY and LIMIT clauses of the query. Sort keys
+// are referred by the selected column alias, unless given in keyExpr.
+func getOrderLimitSQL(opts *base.QueryOptions, keyExpr map[base.Node]string) string {
+	sql := ""
+	for idx, o := range opts.Orderby {
+		if idx == 0 {
+			sql += " ORDER BY "
+		} else {
+			sql += ", "
+		}
+		if expr, ok := keyExpr[o.Node]; ok {
+			sql += expr
+		} else {
+			sql += getSQLAlias(o.Node.Alias)
+		}
+		if o.ASC {
+			sql += " ASC"
+		} else {
+			sql += " DESC"
+		}
+	}
+	if opts.Limit > 0 {
+		sql += fmt.Sprintf(" LIMIT %d", opts.Limit)
+	} else if opts.Offset > 0 {
+		// GoogleSQL and SQLite only accept OFFSET following a LIMIT clause.
+		sql += fmt.Sprintf(" LIMIT %d", math.MaxInt64)
+	}
+	if opts.Offset > 0 {
+		sql += fmt.Sprintf(" OFFSET %d", opts.Offset)
+	}
+	return sql
+}
+
+// getSQLAlias gets the SQL column alias of a query node.
+func getSQLAlias(nodeAlias string) string {
+	return strings.TrimPrefix(strings.ReplaceAll(nodeAlias, "/", "_"), "?")
+}
+
+// sortConstraints sorts constraints by the column to get deterministic result.
+func sortConstraints(constraints []Constraint) {
+	sort.SliceStable(constraints, func(i, j int) bool {
+		return strings.Compare(constraints[i].LHS.String(), constraints[j].LHS.String()) < 0
+	})
+}
+
+// getConstraintSQL gets the SQL condition of a constraint.
+func getConstraintSQL(d base.Dialect, c Constraint) string {
+	switch v := c.RHS.(type) {
+	case base.Column:
+		return fmt.Sprintf("%s.%s = %s.%s", c.LHS.Table.Alias(), c.LHS.Name, v.Table.Alias(), v.Name)
+	case string:
+		// Before we have spanner table reflection, need to hardcode check here.
+		// But the user should really have quote for strings.
+		useQuote := strings.Contains(c.LHS.Table.Name, base.Triple)
+		return fmt.Sprintf("%s.%s = %s", c.LHS.Table.Alias(), c.LHS.Name, addQuote(d, v, useQuote))
+	case []string:
+		strs := []string{}
+		for _, s := range v {
+			strs = append(strs, addQuote(d, s))
+		}
+		return fmt.Sprintf("%s.%s IN (%s)", c.LHS.Table.Alias(), c.LHS.Name, strings.Join(strs, ", "))
+	}
+	return ""
+}
+
+// getNodeConstraint gets the constraint that binds a node to a column. Columns
+// from required tables are preferred over columns from optional tables.
+func getNodeConstraint(
+	n base.Node,
+	constraints []Constraint,
+	optionalTables map[base.Table]struct{}) (Constraint, bool) {
+	var result Constraint
+	found := false
+	for _, c := range constraints {
+		if n != c.RHS {
+			continue
+		}
+		if _, ok := optionalTables[c.LHS.Table]; !ok {
+			return c, true
+		}
+		if !found {
+			result = c
+			found = true
+		}
+	}
+	if !found {
+		return result, false
+	}
+	// Use the joined column from a required table if there is one.
+	for _, c := range constraints {
+		rhs, ok := c.RHS.(base.Column)
+		if !ok {
+			continue
+		}
+		if c.LHS == result.LHS {
+			if _, ok := optionalTables[rhs.Table]; !ok {
+				return Constraint{rhs, n}, true
+			}
+		} else if rhs == result.LHS {
+			if _, ok := optionalTables[c.LHS.Table]; !ok {
+				return Constraint{c.LHS, n}, true
+			}
+		}
+	}
+	return result, true
+}
+
+// getOptionalTables obtains the table instances that are only bound by
+// statements from OPTIONAL graph patterns. These tables are LEFT JOINed.
+func getOptionalTables(
+	bindings []Binding, queryID map[*base.Query]int) map[base.Table]struct{} {
+	optional := map[base.Table]struct{}{}
+	required := map[base.Table]struct{}{}
+	for _, b := range bindings {
+		t := b.Mapping.Sub.Table
+		t.ID = strconv.Itoa(queryID[b.Query])
+		if b.Query.Optional {
+			optional[t] = struct{}{}
+		} else {
+			required[t] = struct{}{}
+		}
+	}
+	for t := range required {
+		delete(optional, t)
+	}
+	return optional
+}
+
+// lessStartTable reports whether table t1 is preferred over t2 as the starting
+// table, when no table has constant constraint.
+func lessStartTable(t1, t2 base.Table, optionalTables map[base.Table]struct{}) bool {
+	_, optional1 := optionalTables[t1]
+	_, optional2 := optionalTables[t2]
+	if optional1 != optional2 {
+		return optional2
+	}
+	return t1.String() < t2.String()
+}
+
+// getOptionalJoinSQL gets the joins of the tables not joined with the starting
+// table through required tables. Required tables only linked through optional
+// tables are CROSS JOINed first, so the optional tables never filter rows. Then
+// each optional table is LEFT JOINed, with its join constraints to the joined
+// tables and its constant constraints in the ON condition.
+func getOptionalJoinSQL(
+	d base.Dialect,
+	optionalTables map[base.Table]struct{},
+	joinedTable map[base.Table]struct{},
+	optionalJoins []Constraint,
+	onConstraints tableConstraint) string {
+	sql := ""
+	sort.SliceStable(optionalJoins, func(i, j int) bool {
+		if optionalJoins[i].LHS != optionalJoins[j].LHS {
+			return optionalJoins[i].LHS.String() < optionalJoins[j].LHS.String()
+		}
+		return optionalJoins[i].RHS.(base.Column).String() < optionalJoins[j].RHS.(base.Column).String()
+	})
+	for _, c := range optionalJoins {
+		for _, t := range []base.Table{c.LHS.Table, c.RHS.(base.Column).Table} {
+			if _, ok := optionalTables[t]; ok {
+				continue
+			}
+			if _, ok := joinedTable[t]; ok {
+				continue
+			}
+			sql += fmt.Sprintf(" CROSS JOIN %s AS %s", t.Name, t.Alias())
+			joinedTable[t] = struct{}{}
+		}
+	}
+	for {
+		// The next optional table is the smallest one linked to a joined table.
+		var next base.Table
+		for _, c := range optionalJoins {
+			rhs := c.RHS.(base.Column)
+			_, lhsJoined := joinedTable[c.LHS.Table]
+			_, rhsJoined := joinedTable[rhs.Table]
+			t := base.Table{}
+			if lhsJoined && !rhsJoined {
+				t = rhs.Table
+			} else if rhsJoined && !lhsJoined {
+				t = c.LHS.Table
+			}
+			if t != (base.Table{}) && (next == (base.Table{}) || t.String() < next.String()) {
+				next = t
+			}
+		}
+		if (base.Table{}) == next {
+			return sql
+		}
+		on := []string{}
+		remaining := []Constraint{}
+		for _, c := range optionalJoins {
+			rhs := c.RHS.(base.Column)
+			if _, ok := joinedTable[rhs.Table]; ok && c.LHS.Table == next {
+				on = append(on, getConstraintSQL(d, Constraint{rhs, c.LHS}))
+			} else if _, ok := joinedTable[c.LHS.Table]; ok && rhs.Table == next {
+				on = append(on, getConstraintSQL(d, c))
+			} else {
+				remaining = append(remaining, c)
+			}
+		}
+		optionalJoins = remaining
+		onCons := onConstraints[next]
+		sortConstraints(onCons)
+		for _, oc := range onCons {
+			on = append(on, getConstraintSQL(d, oc))
+		}
+		sql += fmt.Sprintf(" LEFT JOIN %s AS %s ON %s", next.Name, next.Alias(), strings.Join(on, " AND "))
+		joinedTable[next] = struct{}{}
+	}
+}
+
 func getSQL(
 	nodes []base.Node,
 	constraints []Constraint,
 	constNode map[base.Node]string,
+	optionalTables map[base.Table]struct{},
 	provInfo ProvInfo,
 	opts *base.QueryOptions) (string, map[int][]int, error) {
+	d := getDialect(opts)
 	// prov maps provenance column to node columns
 	prov := map[int][]int{}
 	provCols := map[base.Column]int{}
 	provList := []base.Column{}
 	pc := len(nodes)
+	nodeProv := map[int]string{}
+
+	aggregates := map[base.Node]*base.Aggregate{}
+	for _, a := range opts.Aggregates {
+		aggregates[a.Alias] = a
+	}
+	if len(opts.Aggregates) > 0 || len(opts.GroupBy) > 0 {
+		groupBy := map[base.Node]struct{}{}
+		for _, n := range opts.GroupBy {
+			groupBy[n] = struct{}{}
+		}
+		for _, n := range nodes {
+			if _, ok := aggregates[n]; ok {
+				continue
+			}
+			if _, ok := groupBy[n]; !ok {
+				return "", nil, status.Errorf(
+					codes.InvalidArgument, "Non-aggregated variable %s is not in GROUP BY", n.Alias)
+			}
+		}
+		// Provenance can not be selected with grouped results.
+		provInfo.query = false
+	}
+
 	sql := "SELECT"
 	if opts.Distinct {
 		sql += " DISTINCT"
@@ -649,52 +901,97 @@ func getSQL(
 		if idx != 0 {
 			sql += ","
 		}
-		if str, ok := constNode[n]; ok {
-			sql += fmt.Sprintf(` "%s"`, str)
+		if a, ok := aggregates[n]; ok {
+			c, ok := getNodeConstraint(a.Node, constraints, optionalTables)
+			if !ok {
+				return "", nil, status.Errorf(
+					codes.InvalidArgument, "Aggregated variable %s is not bound in the query", a.Node.Alias)
+			}
+			distinct := ""
+			if a.Distinct {
+				distinct = "DISTINCT "
+			}
+			sql += fmt.Sprintf(" %s(%s%s.%s) AS %s",
+				a.Func, distinct, c.LHS.Table.Alias(), c.LHS.Name, getSQLAlias(n.Alias))
+			continue
 		}
-		for _, c := range constraints {
-			if n == c.RHS {
-				sql += fmt.Sprintf(" %s.%s AS %s",
-					c.LHS.Table.Alias(),
-					c.LHS.Name,
-					strings.TrimPrefix(strings.ReplaceAll(n.Alias, "/", "_"), "?"))
-				if provInfo.query {
-					if provCol, ok := provInfo.tableProv[c.LHS.Table.Name]; ok {
-						provCol.Table.ID = c.LHS.Table.ID
-						if i, ok := provCols[provCol]; ok {
-							prov[i] = append(prov[i], idx)
-						} else {
-							provList = append(provList, provCol)
-							provCols[provCol] = pc
-							prov[pc] = []int{idx}
-							pc++
-						}
+		str, isConst := constNode[n]
+		if isConst {
+			sql += " " + addQuote(d, str, true)
+		}
+		c, ok := getNodeConstraint(n, constraints, optionalTables)
+		if !ok && !isConst {
+			// The node is not bound in this UNION branch.
+			sql += fmt.Sprintf(" NULL AS %s", getSQLAlias(n.Alias))
+			continue
+		}
+		if ok {
+			sql += fmt.Sprintf(" %s.%s AS %s",
+				c.LHS.Table.Alias(),
+				c.LHS.Name,
+				getSQLAlias(n.Alias))
+			if provInfo.query {
+				if provCol, ok := provInfo.tableProv[c.LHS.Table.Name]; ok {
+					provCol.Table.ID = c.LHS.Table.ID
+					if provInfo.perNode {
+						nodeProv[idx] = fmt.Sprintf("%s.%s", provCol.Table.Alias(), provCol.Name)
+					} else if i, ok := provCols[provCol]; ok {
+						prov[i] = append(prov[i], idx)
+					} else {
+						provList = append(provList, provCol)
+						provCols[provCol] = pc
+						prov[pc] = []int{idx}
+						pc++
 					}
 				}
-				break
 			}
 		}
 	}
 	for i, p := range provList {
 		sql += ", " + fmt.Sprintf("%s.%s AS prov%d", p.Table.Alias(), p.Name, i)
 	}
+	if provInfo.query && provInfo.perNode {
+		for idx := range nodes {
+			expr, ok := nodeProv[idx]
+			if !ok {
+				expr = "NULL"
+			}
+			sql += fmt.Sprintf(", %s AS prov%d", expr, idx)
+			prov[len(nodes)+idx] = []int{idx}
+		}
+	}
 
 	tableCounter := map[base.Table]int{}
 	constCounter := map[base.Table]int{}
 	joinConstraints := tableConstraint{}
 	whereConstraints := []Constraint{}
+	// Constant constraints of optional tables are put in the LEFT JOIN condition.
+	onConstraints := tableConstraint{}
+
+	// Joins with optional tables are LEFT JOINed after the required tables.
+	optionalJoins := []Constraint{}
 
 	for _, c := range constraints {
 		tableCounter[c.LHS.Table]++
 		switch v := c.RHS.(type) {
 		case base.Column:
+			tableCounter[v.Table]++
+			_, lhsOptional := optionalTables[c.LHS.Table]
+			_, rhsOptional := optionalTables[v.Table]
+			if lhsOptional || rhsOptional {
+				optionalJoins = append(optionalJoins, c)
+				continue
+			}
 			joinConstraints[c.LHS.Table] = append(joinConstraints[c.LHS.Table], c)
 			joinConstraints[v.Table] = append(joinConstraints[v.Table], c)
-			tableCounter[v.Table]++
 		case base.Node:
 		default:
-			whereConstraints = append(whereConstraints, c)
-			constCounter[c.LHS.Table]++
+			if _, ok := optionalTables[c.LHS.Table]; ok {
+				onConstraints[c.LHS.Table] = append(onConstraints[c.LHS.Table], c)
+			} else {
+				whereConstraints = append(whereConstraints, c)
+				constCounter[c.LHS.Table]++
+			}
 		}
 	}
 
@@ -721,7 +1018,8 @@ func getSQL(
 		joinConstraints[t] = cs
 	}
 
-	// Choose the table with the most constant constraints as the starting table.
+	// Choose the required table with the most constant constraints as the
+	// starting table. Optional tables have no constant counted.
 	var currTable base.Table
 	maxCount := 0
 	for t, count := range constCounter {
@@ -731,16 +1029,25 @@ func getSQL(
 		}
 	}
 	// When there is no constant an no join, need to pick the currTable.
+	// A required table is picked, unless the query only has OPTIONAL patterns.
 	if (base.Table{}) == currTable {
-		for _, c := range constraints {
-			currTable = c.LHS.Table
-			break
+		for t := range tableCounter {
+			if (base.Table{}) == currTable || lessStartTable(t, currTable, optionalTables) {
+				currTable = t
+			}
 		}
 	}
 
+	// The starting table can not be LEFT JOINed.
+	if _, ok := optionalTables[currTable]; ok {
+		whereConstraints = append(whereConstraints, onConstraints[currTable]...)
+		delete(onConstraints, currTable)
+	}
+
 	sql += fmt.Sprintf(" FROM %s AS %s", currTable.Name, currTable.Alias())
 
 	processedTable := map[base.Table]struct{}{currTable: {}}
+	joinedTable := map[base.Table]struct{}{currTable: {}}
 	var currCol, otherCol base.Column
 	for len(joinConstraints) > 0 {
 		futureTables := []base.Table{}
@@ -759,6 +1066,7 @@ func getSQL(
 				sql += fmt.Sprintf(
 					" ON %s.%s = %s.%s",
 					currCol.Table.Alias(), currCol.Name, otherCol.Table.Alias(), otherCol.Name)
+				joinedTable[otherCol.Table] = struct{}{}
 			}
 			joinConstraints.remove(currTable, c)
 			joinConstraints.remove(otherCol.Table, c)
@@ -779,64 +1087,361 @@ func getSQL(
 		}
 	}
 
-	// Sort to get deterministic result.
-	sort.SliceStable(whereConstraints, func(i, j int) bool {
-		return strings.Compare(whereConstraints[i].LHS.String(), whereConstraints[j].LHS.String()) < 0
-	})
+	sql += getOptionalJoinSQL(d, optionalTables, joinedTable, optionalJoins, onConstraints)
+
+	sortConstraints(whereConstraints)
 	for idx, c := range whereConstraints {
 		if idx == 0 {
 			sql += " WHERE "
 		} else if idx != len(whereConstraints) {
 			sql += " AND "
 		}
-		switch v := c.RHS.(type) {
-		case base.Column:
-			sql += fmt.Sprintf("%s.%s = %s.%s", c.LHS.Table.Alias(), c.LHS.Name, v.Table.Alias(), v.Name)
-		case string:
-			// Before we have spanner table reflection, need to hardcode check here.
-			// But the user should really have quote for strings.
-			useQuote := strings.Contains(c.LHS.Table.Name, base.Triple)
-			sql += fmt.Sprintf("%s.%s = %s", c.LHS.Table.Alias(), c.LHS.Name, addQuote(v, useQuote))
-		case []string:
-			strs := []string{}
-			for _, s := range v {
-				strs = append(strs, addQuote(s))
-			}
-			sql += fmt.Sprintf("%s.%s IN (%s)", c.LHS.Table.Alias(), c.LHS.Name, strings.Join(strs, ", "))
-		}
-	}
-	if opts.Orderby != "" {
-		sql += fmt.Sprintf(
-			" ORDER BY %s", strings.TrimPrefix(strings.ReplaceAll(opts.Orderby, "/", "_"), "?"))
-		if opts.ASC {
-			sql += " ASC"
+		sql += getConstraintSQL(d, c)
+	}
+	if len(opts.Filters) > 0 {
+		nodeCols := map[base.Node]string{}
+		for n, str := range constNode {
+			nodeCols[n] = addQuote(d, str, true)
+		}
+		for _, c := range constraints {
+			if n, ok := c.RHS.(base.Node); ok {
+				if _, ok := nodeCols[n]; !ok {
+					nc, _ := getNodeConstraint(n, constraints, optionalTables)
+					nodeCols[n] = fmt.Sprintf("%s.%s", nc.LHS.Table.Alias(), nc.LHS.Name)
+				}
+			}
+		}
+		for idx, f := range opts.Filters {
+			if idx == 0 && len(whereConstraints) == 0 {
+				sql += " WHERE "
+			} else {
+				sql += " AND "
+			}
+			filterSQL, err := getFilterSQL(d, f, nodeCols)
+			if err != nil {
+				return "", nil, err
+			}
+			sql += filterSQL
+		}
+	}
+	for idx, n := range opts.GroupBy {
+		c, ok := getNodeConstraint(n, constraints, optionalTables)
+		if !ok {
+			return "", nil, status.Errorf(
+				codes.InvalidArgument, "Group by variable %s is not bound in the query", n.Alias)
+		}
+		if idx == 0 {
+			sql += " GROUP BY "
 		} else {
-			sql += " DESC"
+			sql += ", "
 		}
+		sql += fmt.Sprintf("%s.%s", c.LHS.Table.Alias(), c.LHS.Name)
 	}
-	if opts.Limit > 0 {
-		sql += fmt.Sprintf(" LIMIT %d", opts.Limit)
+	selected := map[base.Node]struct{}{}
+	for _, n := range nodes {
+		selected[n] = struct{}{}
+	}
+	keyExpr := map[base.Node]string{}
+	for _, o := range opts.Orderby {
+		if _, ok := selected[o.Node]; ok {
+			continue
+		}
+		if opts.Distinct || len(opts.Aggregates) > 0 || len(opts.GroupBy) > 0 {
+			return "", nil, status.Errorf(codes.InvalidArgument,
+				"Order by variable %s must be selected in DISTINCT or aggregate query", o.Node.Alias)
+		}
+		c, ok := getNodeConstraint(o.Node, constraints, optionalTables)
+		if !ok {
+			return "", nil, status.Errorf(
+				codes.InvalidArgument, "Order by variable %s is not bound in the query", o.Node.Alias)
+		}
+		keyExpr[o.Node] = fmt.Sprintf("%s.%s", c.LHS.Table.Alias(), c.LHS.Name)
 	}
+	sql += getOrderLimitSQL(opts, keyExpr)
 	return sql, prov, nil
 }
 
-// Translate takes a datalog query and translates to GoogleSQL query based on schema mapping.
+// getFilterSQL converts a filter or a filter operand to SQL expression.
+func getFilterSQL(
+	d base.Dialect, f interface{}, nodeCols map[base.Node]string) (string, error) {
+	switch v := f.(type) {
+	case *base.Filter:
+		args := []string{}
+		for _, arg := range v.Args {
+			argSQL, err := getFilterSQL(d, arg, nodeCols)
+			if err != nil {
+				return "", err
+			}
+			args = append(args, argSQL)
+		}
+		if v.Op == "NOT" && len(args) == 1 {
+			return fmt.Sprintf("(NOT %s)", args[0]), nil
+		}
+		if len(args) != 2 {
+			return "", status.Errorf(
+				codes.InvalidArgument, "Invalid number of operands for %s: %d", v.Op, len(args))
+		}
+		return fmt.Sprintf("(%s %s %s)", args[0], v.Op, args[1]), nil
+	case base.Node:
+		col, ok := nodeCols[v]
+		if !ok {
+			return "", status.Errorf(
+				codes.InvalidArgument, "Filter variable %s is not bound in the query", v.Alias)
+		}
+		return col, nil
+	case string:
+		return d.String(v), nil
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64), nil
+	case bool:
+		return strings.ToUpper(strconv.FormatBool(v)), nil
+	}
+	return "", status.Errorf(codes.InvalidArgument, "Invalid filter operand %v", f)
+}
+
+// Translate takes a datalog query and translates to SQL query based on schema
+// mapping. The SQL is in the dialect of the query options, GoogleSQL by default.
 func Translate(
 	mappings []*base.Mapping, nodes []base.Node, queries []*base.Query,
 	subTypeMap map[string]string, options ...*base.QueryOptions) (
 	*Translation, error) {
+	var queryOptions *base.QueryOptions
+	if len(options) > 0 {
+		queryOptions = options[0]
+	} else {
+		queryOptions = &base.QueryOptions{}
+	}
+	return translateWithTrace(mappings, nodes, queries, subTypeMap, queryOptions, nil)
+}
+
+// translateWithTrace translates a datalog query and records the translation
+// steps of each branch in the trace, which can be nil.
+func translateWithTrace(
+	mappings []*base.Mapping, nodes []base.Node, queries []*base.Query,
+	subTypeMap map[string]string, opts *base.QueryOptions, trace *Plan) (
+	*Translation, error) {
+	queries, err := expandTransitive(queries, opts.ContainedIn)
+	if err != nil {
+		return nil, err
+	}
+	branches := getBranches(queries)
+	if len(branches) > 1 {
+		return translateUnion(mappings, nodes, branches, subTypeMap, opts, trace)
+	}
+	return translate(mappings, nodes, queries, subTypeMap, opts, false, trace.addBranch())
+}
+
+// getBranches groups query statements by UNION branch.
+func getBranches(queries []*base.Query) [][]*base.Query {
+	branchQueries := map[int][]*base.Query{}
+	branchIDs := []int{}
+	for _, q := range queries {
+		if _, ok := branchQueries[q.Branch]; !ok {
+			branchIDs = append(branchIDs, q.Branch)
+		}
+		branchQueries[q.Branch] = append(branchQueries[q.Branch], q)
+	}
+	sort.Ints(branchIDs)
+	result := [][]*base.Query{}
+	for _, id := range branchIDs {
+		result = append(result, branchQueries[id])
+	}
+	return result
+}
+
+// translateUnion translates each UNION branch and combines the branch SQL with
+// UNION ALL. Every branch selects the same nodes in the same order, so the
+// columns are aligned. The derived table is aliased, as PostgreSQL requires.
+// Filters are applied to the combined rows, so a filter on a node that a
+// branch does not bind only drops the rows of that branch. Aggregates are
+// computed over the combined rows, from the aggregated nodes selected by the
+// branches. Each branch selects a provenance column for every node, which is
+// NULL where the branch has no provenance.
+func translateUnion(
+	mappings []*base.Mapping, nodes []base.Node, branches [][]*base.Query,
+	subTypeMap map[string]string, opts *base.QueryOptions, trace *Plan) (
+	*Translation, error) {
+	selected := map[base.Node]struct{}{}
+	for _, n := range nodes {
+		selected[n] = struct{}{}
+	}
+	for _, o := range opts.Orderby {
+		if _, ok := selected[o.Node]; !ok {
+			return nil, status.Errorf(codes.InvalidArgument,
+				"Order by variable %s must be selected in UNION query", o.Node.Alias)
+		}
+	}
+	aggregates := map[base.Node]*base.Aggregate{}
+	for _, a := range opts.Aggregates {
+		aggregates[a.Alias] = a
+	}
+	grouped := len(opts.Aggregates) > 0 || len(opts.GroupBy) > 0
+	branchNodes := []base.Node{}
+	branchSelected := map[base.Node]struct{}{}
+	addNode := func(n base.Node) {
+		if _, ok := branchSelected[n]; !ok {
+			branchSelected[n] = struct{}{}
+			branchNodes = append(branchNodes, n)
+		}
+	}
+	if grouped {
+		groupBy := map[base.Node]struct{}{}
+		for _, n := range opts.GroupBy {
+			groupBy[n] = struct{}{}
+		}
+		for _, n := range nodes {
+			if a, ok := aggregates[n]; ok {
+				addNode(a.Node)
+				continue
+			}
+			if _, ok := groupBy[n]; !ok {
+				return nil, status.Errorf(
+					codes.InvalidArgument, "Non-aggregated variable %s is not in GROUP BY", n.Alias)
+			}
+			addNode(n)
+		}
+		for _, n := range opts.GroupBy {
+			addNode(n)
+		}
+	} else {
+		branchNodes = append(branchNodes, nodes...)
+		for _, n := range nodes {
+			branchSelected[n] = struct{}{}
+		}
+	}
+	nodeCols := map[base.Node]string{}
+	for _, f := range opts.Filters {
+		for _, n := range getFilterNodes(f) {
+			addNode(n)
+			nodeCols[n] = getSQLAlias(n.Alias)
+		}
+	}
+	// Provenance can not be selected with grouped results.
+	prov := opts.Prov && !grouped
+	branchOpts := &base.QueryOptions{Db: opts.Db, Prov: prov, Dialect: opts.Dialect}
+	result := &Translation{Nodes: nodes, Prov: map[int][]int{}}
+	branchSQL := []string{}
+	for _, queries := range branches {
+		translation, err := translate(
+			mappings, branchNodes, queries, subTypeMap, branchOpts, true, trace.addBranch())
+		if err != nil {
+			return nil, err
+		}
+		branchSQL = append(branchSQL, translation.SQL)
+		result.Bindings = append(result.Bindings, translation.Bindings...)
+		result.Constraint = append(result.Constraint, translation.Constraint...)
+	}
+	if prov {
+		for idx := range nodes {
+			result.Prov[len(nodes)+idx] = []int{idx}
+		}
+	}
+	sql := "SELECT"
+	if opts.Distinct {
+		sql += " DISTINCT"
+	}
+	if !grouped && len(branchNodes) == len(nodes) {
+		sql += " *"
+	}
+	for idx, n := range nodes {
+		if !grouped && len(branchNodes) == len(nodes) {
+			break
+		}
+		if idx != 0 {
+			sql += ","
+		}
+		if a, ok := aggregates[n]; ok {
+			distinct := ""
+			if a.Distinct {
+				distinct = "DISTINCT "
+			}
+			sql += fmt.Sprintf(" %s(%s%s) AS %s",
+				a.Func, distinct, getSQLAlias(a.Node.Alias), getSQLAlias(n.Alias))
+		} else {
+			sql += " " + getSQLAlias(n.Alias)
+		}
+	}
+	if prov && len(branchNodes) != len(nodes) {
+		for idx := range nodes {
+			sql += fmt.Sprintf(", prov%d", idx)
+		}
+	}
+	sql += fmt.Sprintf(" FROM (%s) AS _union", strings.Join(branchSQL, " UNION ALL "))
+	for idx, f := range opts.Filters {
+		if idx == 0 {
+			sql += " WHERE "
+		} else {
+			sql += " AND "
+		}
+		filterSQL, err := getFilterSQL(getDialect(opts), f, nodeCols)
+		if err != nil {
+			return nil, err
+		}
+		sql += filterSQL
+	}
+	for idx, n := range opts.GroupBy {
+		if idx == 0 {
+			sql += " GROUP BY "
+		} else {
+			sql += ", "
+		}
+		sql += getSQLAlias(n.Alias)
+	}
+	result.SQL = sql + getOrderLimitSQL(opts, nil)
+	return result, nil
+}
+
+// getFilterNodes gets the nodes in a filter.
+func getFilterNodes(f interface{}) []base.Node {
+	switch v := f.(type) {
+	case *base.Filter:
+		result := []base.Node{}
+		for _, arg := range v.Args {
+			result = append(result, getFilterNodes(arg)...)
+		}
+		return result
+	case base.Node:
+		return []base.Node{v}
+	}
+	return nil
+}
+
+// translate translates query statements without UNION branches. A provenance
+// column is selected for each node when nodeProv is set. The steps are recorded
+// in the trace, which can be nil.
+func translate(
+	mappings []*base.Mapping, nodes []base.Node, queries []*base.Query,
+	subTypeMap map[string]string, opts *base.QueryOptions, nodeProv bool,
+	trace *BranchPlan) (*Translation, error) {
+	translation, err := translateBranch(
+		mappings, nodes, queries, subTypeMap, opts, nodeProv, trace)
+	if err != nil {
+		trace.setError(err)
+		return nil, err
+	}
+	return translation, nil
+}
+
+func translateBranch(
+	mappings []*base.Mapping, nodes []base.Node, queries []*base.Query,
+	subTypeMap map[string]string, opts *base.QueryOptions, nodeProv bool,
+	trace *BranchPlan) (*Translation, error) {
 	funcDeps, err := GetFuncDeps(mappings)
 	if err != nil {
 		return nil, err
 	}
+	trace.recordFuncDeps(funcDeps)
 
 	tableProv, err := GetProvColumn(mappings)
 	if err != nil {
 		return nil, err
 	}
 
-	mappings = PruneMapping(mappings)
+	pruned := PruneMapping(mappings)
+	trace.recordPrunedMappings(mappings, pruned)
+	mappings = pruned
 	queries = RewriteQuery(queries, subTypeMap)
+	trace.recordQueries(queries)
 	matchTriple, err := MatchTriple(mappings, queries)
 	if err != nil {
 		return nil, err
@@ -847,32 +1452,37 @@ func Translate(
 	if err != nil {
 		return nil, err
 	}
+	trace.recordBindings(queries, queryID, matchTriple, bindingMap)
 	bindingSets := getBindingSets(bindingMap)
+	trace.recordBindingSets(len(bindingSets))
 	if len(bindingSets) > 1 {
 		fmt.Printf("There are %d binding sets\n", len(bindingSets))
 	} else if len(bindingSets) == 0 {
+		unbound := []string{}
+		for _, q := range queries {
+			if len(bindingMap[q]) == 0 {
+				unbound = append(unbound, queryString(q))
+			}
+		}
+		if len(unbound) > 0 {
+			return nil, status.Errorf(codes.Internal,
+				"No mapping found for query statements: %s", strings.Join(unbound, "; "))
+		}
 		return nil, status.Errorf(codes.Internal, "Failed to get translation result")
 	}
 
 	nodeRefs := GetNodeRef(queries)
 	graph := getGraph(bindingSets[0], queryID, nodeRefs)
+	trace.recordGraph(graph)
 	constraints, constNode, err := GetConstraint(graph, funcDeps)
 	if err != nil {
 		return nil, err
 	}
+	trace.recordConstraints(constraints)
 
-	var (
-		queryProv    bool
-		queryOptions *base.QueryOptions
-	)
-	if len(options) > 0 {
-		queryOptions = options[0]
-		queryProv = options[0].Prov
-	} else {
-		queryOptions = &base.QueryOptions{}
-	}
-
-	sql, prov, err := getSQL(nodes, constraints, constNode, ProvInfo{queryProv, tableProv}, queryOptions)
+	optionalTables := getOptionalTables(bindingSets[0], queryID)
+	sql, prov, err := getSQL(
+		nodes, constraints, constNode, optionalTables, ProvInfo{opts.Prov, tableProv, nodeProv}, opts)
 	if err != nil {
 		return nil, err
 	}
@@ -15,6 +15,7 @@
 package translator
 
 import (
+	"database/sql"
 	"fmt"
 	"strings"
 	"testing"
@@ -23,6 +24,7 @@ import (
 	"github.com/datacommonsorg/mixer/internal/sparql"
 
 	"github.com/go-test/deep"
+	_ "modernc.org/sqlite"
 )
 
 func TestBind(t *testing.T) {
@@ -49,28 +51,28 @@ func TestBind(t *testing.T) {
 
 	wantResult := map[string][]*base.Mapping{}
 	v, _ := base.NewMapping("typeOf", "E:Place->E1", "Place", db)
-	wantResult[fmt.Sprintf("%s", queries[0])] = []*base.Mapping{v}
-	wantResult[fmt.Sprintf("%s", queries[1])] = []*base.Mapping{v}
+	wantResult[fmt.Sprintf("%v", queries[0])] = []*base.Mapping{v}
+	wantResult[fmt.Sprintf("%v", queries[1])] = []*base.Mapping{v}
 	v, _ = base.NewMapping("subType", "E:Place->E1", "C:Place->type", db)
-	wantResult[fmt.Sprintf("%s", queries[2])] = []*base.Mapping{v}
+	wantResult[fmt.Sprintf("%v", queries[2])] = []*base.Mapping{v}
 	v, _ = base.NewMapping("dcid", "E:Place->E1", "C:Place->id", db)
-	wantResult[fmt.Sprintf("%s", queries[3])] = []*base.Mapping{v}
+	wantResult[fmt.Sprintf("%v", queries[3])] = []*base.Mapping{v}
 	v, _ = base.NewMapping("timezone", "E:Place->E1", "C:Place->timezone", db)
-	wantResult[fmt.Sprintf("%s", queries[4])] = []*base.Mapping{v}
+	wantResult[fmt.Sprintf("%v", queries[4])] = []*base.Mapping{v}
 	v, _ = base.NewMapping("C:Triple->predicate", "E:Triple->E1", "C:Triple->object_value", db)
-	wantResult[fmt.Sprintf("%s", queries[5])] = []*base.Mapping{v}
+	wantResult[fmt.Sprintf("%v", queries[5])] = []*base.Mapping{v}
 	v, _ = base.NewMapping("dcid", "E:Place->E1", "C:Place->id", db)
-	wantResult[fmt.Sprintf("%s", queries[6])] = []*base.Mapping{v}
+	wantResult[fmt.Sprintf("%v", queries[6])] = []*base.Mapping{v}
 	v, _ = base.NewMapping("name", "E:Place->E1", "C:Place->name", db)
-	wantResult[fmt.Sprintf("%s", queries[7])] = []*base.Mapping{v}
-	wantResult[fmt.Sprintf("%s", queries[8])] = []*base.Mapping{v}
+	wantResult[fmt.Sprintf("%v", queries[7])] = []*base.Mapping{v}
+	wantResult[fmt.Sprintf("%v", queries[8])] = []*base.Mapping{v}
 	v, _ = base.NewMapping("landArea", "E:Place->E1", "E:Place->E3", db)
-	wantResult[fmt.Sprintf("%s", queries[9])] = []*base.Mapping{v}
+	wantResult[fmt.Sprintf("%v", queries[9])] = []*base.Mapping{v}
 
 	bindingMap, err := Bind(mappings, queries)
 	gotResult := map[string][]*base.Mapping{}
 	for q, ms := range bindingMap {
-		gotResult[fmt.Sprintf("%s", q)] = ms
+		gotResult[fmt.Sprintf("%v", q)] = ms
 	}
 	if err != nil {
 		t.Fatalf("bind datalog query %s: %s", queryStr, err)
@@ -228,8 +230,9 @@ func TestGetSQL(t *testing.T) {
 		[]base.Node{n2},
 		constraints,
 		map[base.Node]string{},
-		ProvInfo{true, tableProv},
-		&base.QueryOptions{Limit: 20, Distinct: true, Orderby: "?dcid", ASC: true},
+		map[base.Table]struct{}{},
+		ProvInfo{true, tableProv, false},
+		&base.QueryOptions{Limit: 20, Distinct: true, Orderby: []*base.Orderby{{Node: n2, ASC: true}}},
 	)
 	if err != nil {
 		t.Fatalf("getSQL error: %s", err)
@@ -243,6 +246,46 @@ func TestGetSQL(t *testing.T) {
 	}
 }
 
+func TestGetOrderLimitSQL(t *testing.T) {
+	for _, c := range []struct {
+		opts *base.QueryOptions
+		want string
+	}{
+		{
+			&base.QueryOptions{},
+			"",
+		},
+		{
+			&base.QueryOptions{
+				Orderby: []*base.Orderby{{Node: base.NewNode("?dcid")}},
+				Limit:   10,
+			},
+			" ORDER BY dcid DESC LIMIT 10",
+		},
+		{
+			&base.QueryOptions{
+				Orderby: []*base.Orderby{
+					{Node: base.NewNode("?value")},
+					{Node: base.NewNode("?name"), ASC: true},
+				},
+			},
+			" ORDER BY value DESC, name ASC",
+		},
+		{
+			&base.QueryOptions{Limit: 10, Offset: 30},
+			" LIMIT 10 OFFSET 30",
+		},
+		{
+			&base.QueryOptions{Offset: 30},
+			" LIMIT 9223372036854775807 OFFSET 30",
+		},
+	} {
+		if diff := deep.Equal(c.want, getOrderLimitSQL(c.opts, nil)); diff != nil {
+			t.Errorf("getOrderLimitSQL(%v) unexpected diff %v", c.opts, diff)
+		}
+	}
+}
+
 func TestTranslate(t *testing.T) {
 	subTypeMap, err := GetSubTypeMap("table_types.json")
 	if err != nil {
@@ -1093,13 +1136,229 @@ func TestStatVarObs(t *testing.T) {
 				"WHERE _dc_v3_Place_1.type = \"Country\" " +
 				"AND _dc_v3_StatVarObservation_0.variable_measured = \"Amount_EconomicActivity_GrossNationalIncome_PurchasingPowerParity_PerCapita\"",
 		},
+		{
+			"optional",
+			`
+				SELECT ?place ?name ?unit
+				WHERE {
+				 ?observation typeOf StatVarObservation .
+				 ?observation variableMeasured Count_Person .
+				 ?observation observationAbout ?place .
+				 OPTIONAL { ?observation unit ?unit }
+				 OPTIONAL {
+				  ?place typeOf Place .
+				  ?place name ?name .
+				 }
+				}
+				`,
+			"SELECT _dc_v3_StatVarObservation_0.observation_about AS place, _dc_v3_Place_1.name AS name, _dc_v3_StatVarObservation_0.unit AS unit " +
+				"FROM `dc_v3.StatVarObservation` AS _dc_v3_StatVarObservation_0 " +
+				"LEFT JOIN `dc_v3.Place` AS _dc_v3_Place_1 ON _dc_v3_StatVarObservation_0.observation_about = _dc_v3_Place_1.id " +
+				"WHERE _dc_v3_StatVarObservation_0.variable_measured = \"Count_Person\"",
+		},
+		{
+			"optional-triple",
+			`
+				SELECT ?place ?name
+				WHERE {
+				 ?observation typeOf StatVarObservation .
+				 ?observation variableMeasured Count_Person .
+				 ?observation observationAbout ?place .
+				 OPTIONAL { ?place name ?name }
+				}
+				`,
+			"SELECT _dc_v3_StatVarObservation_0.observation_about AS place, _dc_v3_Triple_0.object_value AS name " +
+				"FROM `dc_v3.StatVarObservation` AS _dc_v3_StatVarObservation_0 " +
+				"LEFT JOIN `dc_v3.Triple` AS _dc_v3_Triple_0 ON _dc_v3_StatVarObservation_0.observation_about = _dc_v3_Triple_0.subject_id " +
+				"AND _dc_v3_Triple_0.predicate = \"name\" " +
+				"WHERE _dc_v3_StatVarObservation_0.variable_measured = \"Count_Person\"",
+		},
+		{
+			"optional-first",
+			`
+				SELECT ?place ?name
+				WHERE {
+				 OPTIONAL {
+				  ?place typeOf Place .
+				  ?place name ?name .
+				 }
+				 ?observation typeOf StatVarObservation .
+				 ?observation observationAbout ?place .
+				}
+				`,
+			"SELECT _dc_v3_StatVarObservation_0.observation_about AS place, _dc_v3_Place_1.name AS name " +
+				"FROM `dc_v3.StatVarObservation` AS _dc_v3_StatVarObservation_0 " +
+				"LEFT JOIN `dc_v3.Place` AS _dc_v3_Place_1 ON _dc_v3_StatVarObservation_0.observation_about = _dc_v3_Place_1.id",
+		},
+		{
+			"optional-links-required",
+			`
+				SELECT ?place ?name ?parentName
+				WHERE {
+				 ?observation typeOf StatVarObservation .
+				 ?observation observationAbout ?place .
+				 OPTIONAL {
+				  ?place typeOf Place .
+				  ?place name ?name .
+				  ?place containedInPlace ?parent .
+				 }
+				 ?parent typeOf Place .
+				 ?parent name ?parentName .
+				}
+				`,
+			"SELECT _dc_v3_StatVarObservation_0.observation_about AS place, _dc_v3_Place_2.name AS name, _dc_v3_Place_1.name AS parentName " +
+				"FROM `dc_v3.Place` AS _dc_v3_Place_1 " +
+				"CROSS JOIN `dc_v3.StatVarObservation` AS _dc_v3_StatVarObservation_0 " +
+				"LEFT JOIN `dc_v3.Place` AS _dc_v3_Place_2 ON _dc_v3_StatVarObservation_0.observation_about = _dc_v3_Place_2.id " +
+				"LEFT JOIN `dc_v3.Triple` AS _dc_v3_Triple_0 ON _dc_v3_Place_1.id = _dc_v3_Triple_0.object_id " +
+				"AND _dc_v3_Place_2.id = _dc_v3_Triple_0.subject_id " +
+				"AND _dc_v3_Triple_0.predicate = \"containedInPlace\"",
+		},
+		{
+			"aggregate",
+			`
+				SELECT ?place (COUNT(?observation) AS ?count) (MAX(?value) AS ?max)
+				WHERE {
+				 ?observation typeOf StatVarObservation .
+				 ?observation variableMeasured Count_Person .
+				 ?observation observationAbout ?place .
+				 ?observation value ?value .
+				}
+				GROUP BY ?place
+				`,
+			"SELECT _dc_v3_StatVarObservation_0.observation_about AS place, " +
+				"COUNT(_dc_v3_StatVarObservation_0.id) AS count, MAX(_dc_v3_StatVarObservation_0.value) AS max " +
+				"FROM `dc_v3.StatVarObservation` AS _dc_v3_StatVarObservation_0 " +
+				"WHERE _dc_v3_StatVarObservation_0.variable_measured = \"Count_Person\" " +
+				"GROUP BY _dc_v3_StatVarObservation_0.observation_about",
+		},
+		{
+			"property-path",
+			`
+				SELECT ?name
+				WHERE {
+				 ?place typeOf County .
+				 ?place containedInPlace/containedInPlace country/USA .
+				 ?place name ?name .
+				}
+				`,
+			"SELECT _dc_v3_Place_0.name AS name " +
+				"FROM `dc_v3.Triple` AS _dc_v3_Triple_1 " +
+				"JOIN `dc_v3.Triple` AS _dc_v3_Triple_0 ON _dc_v3_Triple_1.subject_id = _dc_v3_Triple_0.object_id " +
+				"JOIN `dc_v3.Place` AS _dc_v3_Place_0 ON _dc_v3_Triple_0.subject_id = _dc_v3_Place_0.id " +
+				"WHERE _dc_v3_Place_0.type = \"County\" " +
+				"AND _dc_v3_Triple_0.predicate = \"containedInPlace\" " +
+				"AND _dc_v3_Triple_1.object_value = \"country/USA\" " +
+				"AND _dc_v3_Triple_1.predicate = \"containedInPlace\"",
+		},
+		{
+			"order-by-aggregate",
+			`
+				SELECT ?place (MAX(?value) AS ?max)
+				WHERE {
+				 ?observation typeOf StatVarObservation .
+				 ?observation variableMeasured Count_Person .
+				 ?observation observationAbout ?place .
+				 ?observation value ?value .
+				}
+				GROUP BY ?place
+				ORDER BY DESC(?max) ?place
+				`,
+			"SELECT _dc_v3_StatVarObservation_0.observation_about AS place, " +
+				"MAX(_dc_v3_StatVarObservation_0.value) AS max " +
+				"FROM `dc_v3.StatVarObservation` AS _dc_v3_StatVarObservation_0 " +
+				"WHERE _dc_v3_StatVarObservation_0.variable_measured = \"Count_Person\" " +
+				"GROUP BY _dc_v3_StatVarObservation_0.observation_about " +
+				"ORDER BY max DESC, place ASC",
+		},
+		{
+			"order-by-unselected",
+			`
+				SELECT ?place ?value
+				WHERE {
+				 ?observation typeOf StatVarObservation .
+				 ?observation variableMeasured Count_Person .
+				 ?observation observationAbout ?place .
+				 ?observation observationDate ?date .
+				 ?observation value ?value .
+				}
+				ORDER BY DESC(?date) ?place
+				`,
+			"SELECT _dc_v3_StatVarObservation_0.observation_about AS place, _dc_v3_StatVarObservation_0.value AS value " +
+				"FROM `dc_v3.StatVarObservation` AS _dc_v3_StatVarObservation_0 " +
+				"WHERE _dc_v3_StatVarObservation_0.variable_measured = \"Count_Person\" " +
+				"ORDER BY _dc_v3_StatVarObservation_0.observation_date DESC, place ASC",
+		},
+		{
+			"filter",
+			`
+				SELECT ?place ?value
+				WHERE {
+				 ?observation typeOf StatVarObservation .
+				 ?observation variableMeasured Count_Person .
+				 ?observation observationAbout ?place .
+				 ?observation observationDate ?date .
+				 ?observation value ?value .
+				 FILTER(?value > 1000 && !(?date < "2010"))
+				}
+				`,
+			"SELECT _dc_v3_StatVarObservation_0.observation_about AS place, _dc_v3_StatVarObservation_0.value AS value " +
+				"FROM `dc_v3.StatVarObservation` AS _dc_v3_StatVarObservation_0 " +
+				"WHERE _dc_v3_StatVarObservation_0.variable_measured = \"Count_Person\" " +
+				"AND ((_dc_v3_StatVarObservation_0.value > 1000) AND (NOT (_dc_v3_StatVarObservation_0.observation_date < \"2010\")))",
+		},
+		{
+			"union",
+			`
+				SELECT ?place ?value
+				WHERE {
+				 ?observation typeOf StatVarObservation .
+				 ?observation observationAbout ?place .
+				 ?observation value ?value .
+				 { ?observation variableMeasured Count_Person }
+				 UNION
+				 { ?observation variableMeasured Median_Age_Person }
+				}
+				`,
+			"SELECT * FROM (" +
+				"SELECT _dc_v3_StatVarObservation_0.observation_about AS place, _dc_v3_StatVarObservation_0.value AS value " +
+				"FROM `dc_v3.StatVarObservation` AS _dc_v3_StatVarObservation_0 " +
+				"WHERE _dc_v3_StatVarObservation_0.variable_measured = \"Count_Person\" " +
+				"UNION ALL " +
+				"SELECT _dc_v3_StatVarObservation_0.observation_about AS place, _dc_v3_StatVarObservation_0.value AS value " +
+				"FROM `dc_v3.StatVarObservation` AS _dc_v3_StatVarObservation_0 " +
+				"WHERE _dc_v3_StatVarObservation_0.variable_measured = \"Median_Age_Person\") AS _union",
+		},
+		{
+			"union-filter",
+			`
+				SELECT ?place
+				WHERE {
+				 ?observation typeOf StatVarObservation .
+				 ?observation observationAbout ?place .
+				 { ?observation variableMeasured Count_Person }
+				 UNION
+				 { ?observation variableMeasured Median_Age_Person . ?observation value ?value }
+				 FILTER(?value > 30)
+				}
+				`,
+			"SELECT place FROM (" +
+				"SELECT _dc_v3_StatVarObservation_0.observation_about AS place, NULL AS value " +
+				"FROM `dc_v3.StatVarObservation` AS _dc_v3_StatVarObservation_0 " +
+				"WHERE _dc_v3_StatVarObservation_0.variable_measured = \"Count_Person\" " +
+				"UNION ALL " +
+				"SELECT _dc_v3_StatVarObservation_0.observation_about AS place, _dc_v3_StatVarObservation_0.value AS value " +
+				"FROM `dc_v3.StatVarObservation` AS _dc_v3_StatVarObservation_0 " +
+				"WHERE _dc_v3_StatVarObservation_0.variable_measured = \"Median_Age_Person\") AS _union " +
+				"WHERE (value > 30)",
+		},
 	} {
-		nodes, queries, _, err := sparql.ParseQuery(c.queryStr)
+		nodes, queries, opts, err := sparql.ParseQuery(c.queryStr)
 		if err != nil {
 			t.Errorf("ParseQuery error: %s", err)
 			continue
 		}
-		translation, err := Translate(mappings, nodes, queries, subTypeMap)
+		translation, err := Translate(mappings, nodes, queries, subTypeMap, opts)
 		if err != nil {
 			t.Errorf("Translate(%s) = %s", c.name, err)
 		}
@@ -1109,3 +1368,260 @@ func TestStatVarObs(t *testing.T) {
 		}
 	}
 }
+
+func TestTranslateDialect(t *testing.T) {
+	mcf := `
+Node: E:Place->E1
+typeOf: Place
+dcid: C:Place->id
+name: C:Place->name
+functionalDeps: dcid
+`
+	queryStr := `SELECT ?name
+		WHERE {
+			?p typeOf Place .
+			?p dcid "geoId/O'Hare" .
+			?p name ?name
+			FILTER(?name != "Bay \"Area\"")
+		}
+		ORDER BY ?name
+		LIMIT 3 OFFSET 2`
+	for _, c := range []struct {
+		dialect base.Dialect
+		db      string
+		want    string
+	}{
+		{
+			base.BigQuery,
+			"dc",
+			"SELECT _dc_Place_0.name AS name FROM `dc.Place` AS _dc_Place_0 " +
+				`WHERE _dc_Place_0.id = "geoId/O'Hare" AND (_dc_Place_0.name != "Bay \"Area\"") ` +
+				"ORDER BY name ASC LIMIT 3 OFFSET 2",
+		},
+		{
+			base.PostgreSQL,
+			"dc",
+			`SELECT _dc___Place_0.name AS name FROM "dc"."Place" AS _dc___Place_0 ` +
+				`WHERE _dc___Place_0.id = 'geoId/O''Hare' AND (_dc___Place_0.name != 'Bay "Area"') ` +
+				"ORDER BY name ASC LIMIT 3 OFFSET 2",
+		},
+		{
+			base.SQLite,
+			"",
+			`SELECT _Place_0.name AS name FROM "Place" AS _Place_0 ` +
+				`WHERE _Place_0.id = 'geoId/O''Hare' AND (_Place_0.name != 'Bay "Area"') ` +
+				"ORDER BY name ASC LIMIT 3 OFFSET 2",
+		},
+	} {
+		mappings, err := ParseMapping(mcf, c.db, c.dialect)
+		if err != nil {
+			t.Fatalf("ParseMapping() = %s", err)
+		}
+		nodes, queries, opts, err := sparql.ParseQuery(queryStr)
+		if err != nil {
+			t.Fatalf("ParseQuery() = %s", err)
+		}
+		opts.Dialect = c.dialect
+		translation, err := Translate(mappings, nodes, queries, map[string]string{}, opts)
+		if err != nil {
+			t.Fatalf("Translate() = %s", err)
+		}
+		if translation.SQL != c.want {
+			t.Errorf("%s SQL = %s, want %s", c.dialect.Name(), translation.SQL, c.want)
+		}
+	}
+}
+
+func TestTranslateSQLite(t *testing.T) {
+	db, err := sql.Open("sqlite", ":memory:")
+	if err != nil {
+		t.Fatalf("sql.Open() = %s", err)
+	}
+	defer db.Close()
+	// An in-memory database only lives as long as its connection.
+	db.SetMaxOpenConns(1)
+	for _, stmt := range []string{
+		`CREATE TABLE Place (id TEXT, name TEXT, type TEXT)`,
+		`CREATE TABLE Triple (
+			subject_id TEXT, predicate TEXT, object_id TEXT, object_value TEXT, prov_id TEXT)`,
+		`INSERT INTO Place VALUES
+			('geoId/06', 'California', 'State'),
+			('geoId/06085', 'Santa Clara', 'County'),
+			('geoId/O''Hare', 'O''Hare', 'City'),
+			('geoId/Bay', 'Bay "Area"', 'City')`,
+		`INSERT INTO Triple VALUES
+			('geoId/06085', 'containedInPlace', 'geoId/06', NULL, 'dc/p1'),
+			('geoId/06085', 'alternateName', NULL, 'SCC', 'dc/p2')`,
+	} {
+		if _, err := db.Exec(stmt); err != nil {
+			t.Fatalf("Exec(%s) = %s", stmt, err)
+		}
+	}
+	mappings, err := ParseMapping(`
+Node: E:Place->E1
+typeOf: Place
+subType: C:Place->type
+dcid: C:Place->id
+name: C:Place->name
+functionalDeps: dcid
+
+Node: E:Triple->E1
+dcid: C:Triple->subject_id
+provenance: E:Triple->E2
+C:Triple->predicate: C:Triple->object_value
+functionalDeps: dcid
+
+Node: E:Triple->E2
+typeOf: Provenance
+dcid: C:Triple->prov_id
+functionalDeps: dcid
+`, "", base.SQLite)
+	if err != nil {
+		t.Fatalf("ParseMapping() = %s", err)
+	}
+	for _, c := range []struct {
+		name     string
+		queryStr string
+		prov     bool
+		want     [][]string
+	}{
+		{
+			"quote",
+			`SELECT ?name
+			WHERE {
+				?p typeOf Place .
+				?p dcid ?dcid .
+				?p name ?name
+				FILTER(?dcid = "geoId/O'Hare" || ?name = "Bay \"Area\"")
+			}
+			ORDER BY ?name`,
+			false,
+			[][]string{{`Bay "Area"`}, {"O'Hare"}},
+		},
+		{
+			"limit",
+			`SELECT ?name WHERE { ?p typeOf Place . ?p name ?name } ORDER BY ?name LIMIT 2 OFFSET 1`,
+			false,
+			[][]string{{"California"}, {"O'Hare"}},
+		},
+		{
+			"union",
+			`SELECT ?name
+			WHERE {
+				{ ?p typeOf Place . ?p subType State . ?p name ?name }
+				UNION
+				{ ?p typeOf Place . ?p subType County . ?p name ?name }
+			}
+			ORDER BY ?name`,
+			false,
+			[][]string{{"California"}, {"Santa Clara"}},
+		},
+		{
+			"union-filter",
+			`SELECT ?name
+			WHERE {
+				?p typeOf Place .
+				?p name ?name .
+				{ ?p subType County }
+				UNION
+				{ ?p subType City . ?p dcid ?dcid }
+				FILTER(?dcid != "geoId/Bay")
+			}
+			ORDER BY ?name`,
+			false,
+			[][]string{{"O'Hare"}},
+		},
+		{
+			"union-provenance",
+			`SELECT ?name ?alternateName
+			WHERE {
+				?p typeOf Place .
+				?p name ?name .
+				{ ?p subType State }
+				UNION
+				{ ?p alternateName ?alternateName }
+			}
+			ORDER BY ?name`,
+			true,
+			[][]string{{"California", "", "", ""}, {"Santa Clara", "SCC", "", "dc/p2"}},
+		},
+		{
+			"optional",
+			`SELECT ?name ?parentName
+			WHERE {
+				?p typeOf Place .
+				?p subType ?type .
+				?p name ?name .
+				OPTIONAL {
+					?p containedInPlace ?parent .
+					?parent typeOf Place .
+					?parent name ?parentName
+				}
+				FILTER(?type != "City")
+			}
+			ORDER BY ?name`,
+			false,
+			[][]string{{"California", ""}, {"Santa Clara", "California"}},
+		},
+		{
+			"aggregate",
+			`SELECT ?type (COUNT(?p) AS ?count)
+			WHERE { ?p typeOf Place . ?p subType ?type }
+			GROUP BY ?type
+			ORDER BY ?type`,
+			false,
+			[][]string{{"City", "2"}, {"County", "1"}, {"State", "1"}},
+		},
+	} {
+		nodes, queries, opts, err := sparql.ParseQuery(c.queryStr)
+		if err != nil {
+			t.Errorf("ParseQuery(%s) = %s", c.name, err)
+			continue
+		}
+		opts.Dialect = base.SQLite
+		opts.Prov = c.prov
+		translation, err := Translate(mappings, nodes, queries, map[string]string{}, opts)
+		if err != nil {
+			t.Errorf("Translate(%s) = %s", c.name, err)
+			continue
+		}
+		rows, err := db.Query(translation.SQL)
+		if err != nil {
+			t.Errorf("Query(%s) = %s, SQL: %s", c.name, err, translation.SQL)
+			continue
+		}
+		columns, err := rows.Columns()
+		if err != nil {
+			t.Fatalf("Columns(%s) = %s", c.name, err)
+		}
+		got := [][]string{}
+		for rows.Next() {
+			values := make([]sql.NullString, len(columns))
+			dest := make([]interface{}, len(columns))
+			for i := range values {
+				dest[i] = &values[i]
+			}
+			if err := rows.Scan(dest...); err != nil {
+				t.Fatalf("Scan(%s) = %s", c.name, err)
+			}
+			row := []string{}
+			for _, v := range values {
+				row = append(row, v.String)
+			}
+			got = append(got, row)
+		}
+		if err := rows.Err(); err != nil {
+			t.Errorf("Rows(%s) = %s", c.name, err)
+		}
+		rows.Close()
+		if diff := deep.Equal(c.want, got); diff != nil {
+			t.Errorf("Query(%s) unexpected rows diff %v, SQL: %s", c.name, diff, translation.SQL)
+		}
+	}
+
+	var name string
+	query := "SELECT name FROM Place WHERE " + base.SQLite.ContainsWord("name", "Clara")
+	if err := db.QueryRow(query).Scan(&name); err != nil || name != "Santa Clara" {
+		t.Errorf("QueryRow(%s) got %s, %v, want Santa Clara", query, name, err)
+	}
+}
@@ -0,0 +1,244 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package translator
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/datacommonsorg/mixer/internal/base"
+)
+
+// MappingFile is a schema mapping mcf file.
+type MappingFile struct {
+	Name    string
+	Content string
+}
+
+// MappingError is a problem found in a schema mapping file.
+type MappingError struct {
+	File string
+	// 1-based line number.
+	Line int
+	Msg  string
+}
+
+func (e *MappingError) Error() string {
+	return fmt.Sprintf("%s:%d: %s", e.File, e.Line, e.Msg)
+}
+
+// TableSchema holds the column names of each table, keyed by table name.
+type TableSchema map[string]map[string]bool
+
+// ParseTableSchema parses a JSON object from table name to the table schema
+// dumped by "bq show --schema --format=prettyjson <dataset>.<table>".
+func ParseTableSchema(b []byte) (TableSchema, error) {
+	raw := map[string][]struct {
+		Name string `json:"name"`
+	}{}
+	if err := json.Unmarshal(b, &raw); err != nil {
+		return nil, err
+	}
+	result := TableSchema{}
+	for table, fields := range raw {
+		result[table] = map[string]bool{}
+		for _, f := range fields {
+			result[table][f.Name] = true
+		}
+	}
+	return result, nil
+}
+
+// mappingPos is the position of a term in the schema mapping files.
+type mappingPos struct {
+	file string
+	line int
+}
+
+// mappingNode is a node block in the schema mapping files.
+type mappingNode struct {
+	id    string
+	table string
+	pos   mappingPos
+	preds map[string]bool
+	// Position of the functionalDeps line, nil when missing.
+	funcDepsPos *mappingPos
+	funcDeps    []string
+}
+
+// mappingValidator collects the problems of schema mapping files.
+type mappingValidator struct {
+	tables TableSchema
+	nodes  map[string]*mappingNode
+	// Entity references with their positions.
+	refs   map[string][]mappingPos
+	errors []*MappingError
+}
+
+func (v *mappingValidator) addError(pos mappingPos, format string, args ...interface{}) {
+	v.errors = append(v.errors,
+		&MappingError{File: pos.file, Line: pos.line, Msg: fmt.Sprintf(format, args...)})
+}
+
+// splitTerm splits an entity or column term like "E:Table->E1" into the table
+// name and the entity id or column name.
+func splitTerm(term, prefix string) (string, string, bool) {
+	if !strings.HasPrefix(term, prefix) {
+		return "", "", false
+	}
+	parts := strings.SplitN(strings.TrimPrefix(term, prefix), base.Arrow, 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", false
+	}
+	return parts[0], parts[1], true
+}
+
+// checkColumn checks a column term of a node.
+func (v *mappingValidator) checkColumn(term string, node *mappingNode, pos mappingPos) {
+	table, column, ok := splitTerm(term, base.PreC)
+	if !ok {
+		v.addError(pos, "invalid column %s", term)
+		return
+	}
+	if table != node.table {
+		v.addError(pos, "column %s is not in the table %s of node %s", term, node.table, node.id)
+		return
+	}
+	v.checkTable(table, column, pos)
+}
+
+// checkTable checks the table and column against the BigQuery table schema if
+// it is given.
+func (v *mappingValidator) checkTable(table, column string, pos mappingPos) {
+	if v.tables == nil {
+		return
+	}
+	columns, ok := v.tables[table]
+	if !ok {
+		v.addError(pos, "unknown table %s", table)
+		return
+	}
+	if column != "" && !columns[column] {
+		v.addError(pos, "unknown column %s in table %s", column, table)
+	}
+}
+
+func (v *mappingValidator) parseFile(f *MappingFile) {
+	var node *mappingNode
+	for i, line := range strings.Split(f.Content, "\n") {
+		pos := mappingPos{f.Name, i + 1}
+		line = strings.TrimSpace(line)
+		if strings.HasPrefix(line, "#") || line == "" {
+			continue
+		}
+		parts := strings.SplitN(line, ": ", 2)
+		if len(parts) < 2 {
+			v.addError(pos, "invalid line %q, expect \"head: value\"", line)
+			continue
+		}
+		head := strings.TrimSpace(parts[0])
+		body := strings.TrimSuffix(strings.TrimPrefix(strings.TrimSpace(parts[1]), `"`), `"`)
+
+		if head == "Node" {
+			node = nil
+			table, _, ok := splitTerm(body, base.PreE)
+			if !ok {
+				v.addError(pos, "invalid node identifier %s", body)
+				continue
+			}
+			if n, ok := v.nodes[body]; ok {
+				v.addError(pos, "duplicate node %s, first defined at %s:%d",
+					body, n.pos.file, n.pos.line)
+				continue
+			}
+			node = &mappingNode{id: body, table: table, pos: pos, preds: map[string]bool{}}
+			v.nodes[body] = node
+			v.checkTable(table, "", pos)
+			continue
+		}
+		if node == nil {
+			v.addError(pos, "missing valid Node identifier before %s", head)
+			continue
+		}
+		if head == "functionalDeps" {
+			if node.funcDepsPos != nil {
+				v.addError(pos, "duplicate functionalDeps for node %s", node.id)
+				continue
+			}
+			p := pos
+			node.funcDepsPos = &p
+			for _, dep := range strings.Split(body, ",") {
+				node.funcDeps = append(node.funcDeps, strings.TrimSpace(dep))
+			}
+			continue
+		}
+		if strings.HasPrefix(head, base.PreC) {
+			v.checkColumn(head, node, pos)
+		} else {
+			node.preds[head] = true
+		}
+		if strings.HasPrefix(body, base.PreC) {
+			v.checkColumn(body, node, pos)
+		} else if strings.HasPrefix(body, base.PreE) {
+			if _, _, ok := splitTerm(body, base.PreE); !ok {
+				v.addError(pos, "invalid entity %s", body)
+				continue
+			}
+			v.refs[body] = append(v.refs[body], pos)
+		}
+	}
+}
+
+// ValidateMapping validates schema mapping files, and returns all the problems
+// found, sorted by file and line. Tables and columns are checked only when the
+// table schema is not nil.
+func ValidateMapping(files []*MappingFile, tables TableSchema) []*MappingError {
+	v := &mappingValidator{
+		tables: tables,
+		nodes:  map[string]*mappingNode{},
+		refs:   map[string][]mappingPos{},
+	}
+	for _, f := range files {
+		v.parseFile(f)
+	}
+	for _, node := range v.nodes {
+		if node.funcDepsPos == nil {
+			v.addError(node.pos, "missing functionalDeps for node %s", node.id)
+			continue
+		}
+		for _, dep := range node.funcDeps {
+			if !node.preds[dep] {
+				v.addError(*node.funcDepsPos,
+					"functional dependency %s is not a predicate of node %s", dep, node.id)
+			}
+		}
+	}
+	for ref, positions := range v.refs {
+		if _, ok := v.nodes[ref]; !ok {
+			for _, pos := range positions {
+				v.addError(pos, "dangling entity reference %s", ref)
+			}
+		}
+	}
+	sort.SliceStable(v.errors, func(i, j int) bool {
+		if v.errors[i].File != v.errors[j].File {
+			return v.errors[i].File < v.errors[j].File
+		}
+		return v.errors[i].Line < v.errors[j].Line
+	})
+	return v.errors
+}
@@ -0,0 +1,124 @@
+// Copyright 2021 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package translator
+
+import (
+	"testing"
+
+	"github.com/go-test/deep"
+)
+
+func TestValidateMapping(t *testing.T) {
+	place := `Node: E:Place->E1
+typeOf: Place
+dcid: C:Place->id
+name: C:Place->name
+containedInPlace: E:Place->E2
+functionalDeps: dcid
+
+Node: E:Place->E2
+typeOf: Place
+dcid: C:Place->parent_id
+functionalDeps: dcid
+`
+	tables := TableSchema{
+		"Place":  {"id": true, "name": true, "parent_id": true},
+		"Source": {"id": true, "domain": true},
+	}
+
+	for _, c := range []struct {
+		files  []*MappingFile
+		tables TableSchema
+		want   []string
+	}{
+		{
+			[]*MappingFile{{"place.mcf", place}},
+			tables,
+			nil,
+		},
+		{
+			[]*MappingFile{
+				{"place.mcf", place},
+				{"source.mcf", `Node: E:Source->E1
+typeOf: Source
+dcid: C:Source->id
+domain: C:Source->domain_name
+provenance: E:Source->E2
+url C:Source->url
+
+Node: E:Place->E1
+typeOf: Place
+functionalDeps: dcid`},
+			},
+			tables,
+			[]string{
+				"source.mcf:1: missing functionalDeps for node E:Source->E1",
+				"source.mcf:4: unknown column domain_name in table Source",
+				"source.mcf:5: dangling entity reference E:Source->E2",
+				`source.mcf:6: invalid line "url C:Source->url", expect "head: value"`,
+				"source.mcf:8: duplicate node E:Place->E1, first defined at place.mcf:1",
+				"source.mcf:9: missing valid Node identifier before typeOf",
+				"source.mcf:10: missing valid Node identifier before functionalDeps",
+			},
+		},
+		{
+			[]*MappingFile{
+				{"obs.mcf", `typeOf: Observation
+
+Node: E:Observation->E1
+typeOf: Observation
+dcid: C:Observation->id
+value: C:Place->value
+functionalDeps: dcid, observationDate
+`},
+			},
+			nil,
+			[]string{
+				"obs.mcf:1: missing valid Node identifier before typeOf",
+				"obs.mcf:6: column C:Place->value is not in the table Observation of node E:Observation->E1",
+				"obs.mcf:7: functional dependency observationDate is not a predicate of node E:Observation->E1",
+			},
+		},
+		{
+			[]*MappingFile{{"obs.mcf", "Node: E:Observation->E1\ntypeOf: Observation\nfunctionalDeps: typeOf"}},
+			tables,
+			[]string{"obs.mcf:1: unknown table Observation"},
+		},
+	} {
+		var got []string
+		for _, e := range ValidateMapping(c.files, c.tables) {
+			got = append(got, e.Error())
+		}
+		if diff := deep.Equal(c.want, got); diff != nil {
+			t.Errorf("ValidateMapping() unexpected diff %v", diff)
+		}
+	}
+}
+
+func TestParseTableSchema(t *testing.T) {
+	got, err := ParseTableSchema([]byte(`{
+  "Place": [
+    {"mode": "REQUIRED", "name": "id", "type": "STRING"},
+    {"mode": "NULLABLE", "name": "name", "type": "STRING"}
+  ]
+}`))
+	if err != nil {
+		t.Fatalf("ParseTableSchema() = %s", err)
+	}
+	want := TableSchema{"Place": {"id": true, "name": true}}
+	if diff := deep.Equal(want, got); diff != nil {
+		t.Errorf("ParseTableSchema() unexpected diff %v", diff)
+	}
+}
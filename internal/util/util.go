@@ -17,6 +17,8 @@ package util
 import (
 	"bytes"
 	"compress/gzip"
+	// Embed the built-in type relations.
+	_ "embed"
 	"encoding/base64"
 	"encoding/json"
 	"fmt"
@@ -173,25 +175,48 @@ func StringContainedIn(target string, strs []string) bool {
 	return false
 }
 
-// GetContainedIn returns the contained in relation change given two types.
-func GetContainedIn(typeRelationJSONFilePath string) (map[TypePair][]string, error) {
-	typeRelationJSON, err := ioutil.ReadFile(typeRelationJSONFilePath)
-	if err != nil {
-		return nil, err
+// Place type relations built into the binary.
+//
+//go:embed type_relation.json
+var defaultTypeRelationJSON []byte
+
+// readContainedInLinks reads the direct containedInPlace relations from a type
+// relation JSON file, keyed by the child type. The built-in type relations are
+// used when the file path is empty.
+func readContainedInLinks(typeRelationJSONFilePath string) (map[string][]string, error) {
+	typeRelationJSON := defaultTypeRelationJSON
+	if typeRelationJSONFilePath != "" {
+		var err error
+		typeRelationJSON, err = ioutil.ReadFile(typeRelationJSONFilePath)
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	ti := []typeInfo{}
-	err = json.Unmarshal(typeRelationJSON, &ti)
+	err := json.Unmarshal(typeRelationJSON, &ti)
 	if err != nil {
 		return nil, err
 	}
-	result := make(map[TypePair][]string)
 	link := map[string][]string{}
 	for _, info := range ti {
 		if info.Predicate == "containedInPlace" {
 			link[info.SubType] = append(link[info.SubType], info.ObjType)
-			pair := TypePair{Child: info.SubType, Parent: info.ObjType}
-			result[pair] = []string{}
+		}
+	}
+	return link, nil
+}
+
+// GetContainedIn returns the contained in relation change given two types.
+func GetContainedIn(typeRelationJSONFilePath string) (map[TypePair][]string, error) {
+	link, err := readContainedInLinks(typeRelationJSONFilePath)
+	if err != nil {
+		return nil, err
+	}
+	result := make(map[TypePair][]string)
+	for c, ps := range link {
+		for _, p := range ps {
+			result[TypePair{Child: c, Parent: p}] = []string{}
 		}
 	}
 	for c, ps := range link {
@@ -208,6 +233,45 @@ func GetContainedIn(typeRelationJSONFilePath string) (map[TypePair][]string, err
 	return result, nil
 }
 
+// GetContainedInDepths returns the numbers of containedInPlace steps from a
+// type to each of its ancestor types, in ascending order. A pair of types has
+// more than one depth when there are several chains of types between them.
+func GetContainedInDepths(typeRelationJSONFilePath string) (map[TypePair][]int, error) {
+	link, err := readContainedInLinks(typeRelationJSONFilePath)
+	if err != nil {
+		return nil, err
+	}
+	result := map[TypePair][]int{}
+	for c := range link {
+		// Types on the current chain, to stop at cycles.
+		onChain := map[string]bool{c: true}
+		var walk func(curr string, depth int)
+		walk = func(curr string, depth int) {
+			for _, p := range link[curr] {
+				if onChain[p] {
+					continue
+				}
+				pair := TypePair{Child: c, Parent: p}
+				found := false
+				for _, d := range result[pair] {
+					found = found || d == depth
+				}
+				if !found {
+					result[pair] = append(result[pair], depth)
+				}
+				onChain[p] = true
+				walk(p, depth+1)
+				delete(onChain, p)
+			}
+		}
+		walk(c, 1)
+	}
+	for _, depths := range result {
+		sort.Ints(depths)
+	}
+	return result, nil
+}
+
 // SnakeToCamel converts a snake case string to camel case string.
 func SnakeToCamel(s string) string {
 	if !strings.Contains(s, "_") {
@@ -1,6 +1,8 @@
 package util
 
 import (
+	"io/ioutil"
+	"os"
 	"testing"
 
 	pb "github.com/datacommonsorg/mixer/internal/proto"
@@ -200,3 +202,56 @@ func TestSample(t *testing.T) {
 	}
 
 }
+
+func TestGetContainedInDepths(t *testing.T) {
+	f, err := ioutil.TempFile("", "type_relation*.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(f.Name())
+	if _, err := f.WriteString(`[
+		{"predicate": "containedInPlace", "subType": "County", "objType": "State"},
+		{"predicate": "containedInPlace", "subType": "County", "objType": "Country"},
+		{"predicate": "containedInPlace", "subType": "State", "objType": "Country"},
+		{"predicate": "containedInPlace", "subType": "Country", "objType": "Continent"},
+		{"predicate": "name", "subType": "State", "objType": "Text"}
+	]`); err != nil {
+		t.Fatal(err)
+	}
+	f.Close()
+	got, err := GetContainedInDepths(f.Name())
+	if err != nil {
+		t.Fatalf("GetContainedInDepths() = %s", err)
+	}
+	want := map[TypePair][]int{
+		{"County", "State"}:      {1},
+		{"County", "Country"}:    {1, 2},
+		{"County", "Continent"}:  {2, 3},
+		{"State", "Country"}:     {1},
+		{"State", "Continent"}:   {2},
+		{"Country", "Continent"}: {1},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("GetContainedInDepths() got diff %v", diff)
+	}
+}
+
+func TestDefaultContainedInDepths(t *testing.T) {
+	got, err := GetContainedInDepths("")
+	if err != nil {
+		t.Fatalf("GetContainedInDepths() = %s", err)
+	}
+	for _, c := range []struct {
+		pair TypePair
+		want []int
+	}{
+		{TypePair{"County", "State"}, []int{1}},
+		{TypePair{"County", "Country"}, []int{1, 2}},
+		{TypePair{"City", "Continent"}, []int{2, 3, 4}},
+		{TypePair{"State", "County"}, nil},
+	} {
+		if diff := cmp.Diff(c.want, got[c.pair]); diff != "" {
+			t.Errorf("GetContainedInDepths()[%v] got diff %v", c.pair, diff)
+		}
+	}
+}
@@ -29,6 +29,7 @@ import (
 	"cloud.google.com/go/bigtable"
 	pb "github.com/datacommonsorg/mixer/internal/proto"
 	"github.com/datacommonsorg/mixer/internal/server"
+	mixerstore "github.com/datacommonsorg/mixer/internal/store"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/reflection"
 	"google.golang.org/protobuf/encoding/protojson"
@@ -95,7 +96,7 @@ func setupInternal(
 	}
 	var cache *server.Cache
 	if useCache {
-		cache, err = server.NewCache(ctx, baseTable)
+		cache, err = server.NewCache(ctx, mixerstore.NewBigtableReader(baseTable))
 		if err != nil {
 			return nil, err
 		}